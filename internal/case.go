@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+// foldExpansions are the Latin letters and ligatures that case folding turns into several letters.
+// Every other letter gets its simple case folding, so the other full foldings, such as those of the Greek letters
+// with a ypogegrammeni or of the Armenian ligatures, are not applied.
+var foldExpansions = map[rune][]rune{
+	'ß': []rune("ss"), 'ẞ': []rune("ss"), 'ŉ': []rune("ʼn"), 'İ': []rune("i̇"),
+	'ﬀ': []rune("ff"), 'ﬁ': []rune("fi"), 'ﬂ': []rune("fl"), 'ﬃ': []rune("ffi"), 'ﬄ': []rune("ffl"),
+	'ﬅ': []rune("st"), 'ﬆ': []rune("st"),
+}
+
+// caseNormalizer lowercases or folds the case of the text as the case mode says.
+// Folding is the simple case folding, plus the expansions of foldExpansions.
+func (n Normalize) caseNormalizer(text *Text) {
+	switch options.CaseMode(n.caseMode) {
+	case options.CasePreserve:
+	case options.CaseFold:
+		text.Expand(func(r rune) []rune {
+			return foldExpansions[r]
+		})
+		text.Map(foldCase)
+	default:
+		text.Map(unicode.ToLower)
+	}
+}
+
+// foldCase returns the simple case folding of r, which also unifies forms such as "ς" and "σ"
+// or "ſ" and "s" that lowercasing keeps apart
+func foldCase(r rune) rune {
+	if r == 'ı' { // the dotless i has no case folding, its upper case is the plain I
+		return r
+	}
+	return unicode.ToLower(unicode.ToUpper(r))
+}
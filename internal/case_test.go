@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_caseNormalizer(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  options.CaseMode
+		input string
+		want  string
+	}{
+		{name: "lowercase by default", input: "SNAPP Straße ΣΟΦΟΣ", want: "snapp straße σοφοσ"},
+		{name: "lowercase", mode: options.CaseLower, input: "Snapp ﬁle", want: "snapp ﬁle"},
+		{name: "preserve", mode: options.CasePreserve, input: "Snapp ΣΟΦΟΣ", want: "Snapp ΣΟΦΟΣ"},
+		{name: "fold expands ligatures and sharp s", mode: options.CaseFold, input: "ﬁle Straße", want: "file strasse"},
+		{name: "fold unifies final sigma, long s and kelvin", mode: options.CaseFold, input: "σοφος ſ K", want: "σοφοσ s k"},
+		{name: "fold keeps dotless i", mode: options.CaseFold, input: "ıI", want: "ıi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := NewText(tt.input)
+			Normalize{caseMode: string(tt.mode)}.caseNormalizer(text)
+			if got := text.String(); got != tt.want {
+				t.Errorf("caseNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,85 +2,19 @@ package internal
 
 // Persian and Arabic Characters
 const (
-	// combining characters
-	tilde                      rune = 771  // COMBINING tilde
-	leftHalfRingBelow          rune = 796  // COMBINING left half ring below
-	dotBelow                   rune = 803  // COMBINING dot below
-	diaeresisBelow             rune = 804  // COMBINING diaeresis below
-	ringBelow                  rune = 805  // COMBINING ring below
-	ogonek                     rune = 808  // COMBINING ogonek
-	verticalLineBelow          rune = 809  // COMBINING vertical line below
-	breveBelow                 rune = 814  // COMBINING breve below
-	invertedBreveBelow         rune = 815  // COMBINING inverted breve below
-	longStrokeOverlay          rune = 822  // COMBINING long stroke overlay
-	fermata                    rune = 850  // COMBINING fermata
-	doubleBreveBelow           rune = 860  // COMBINING double breve below
-	doubleRightwardsArrowBelow rune = 866  // COMBINING double rightwards arrow bellow
-	plusSignBelow              rune = 799  // COMBINING plus sign below
-	lowLine                    rune = 818  // COMBINING LOW LINE
-	DIAERESIS                  rune = 776  // COMBINING DIAERESIS
-	seagullBelow               rune = 828  // COMBINING SEAGULL BELOW
-	leftAngleAbove             rune = 794  // COMBINING LEFT ANGLE ABOVE
-	acuteAccent                rune = 769  // COMBINING ACUTE ACCENT
-	upTackBelow                rune = 797  // COMBINING UP TACK BELOW
-	candrabindu                rune = 784  // COMBINING candrabindu
-	caronBelow                 rune = 812  // COMBINING caron BELOW
-	snakeBelow                 rune = 7618 // COMBINING SNAKE BELOW
-	dotAbove                   rune = 775  // COMBINING DOT ABOVE
-	doubleMacronBelow          rune = 863  // COMBINING DOUBLE macron BELOW
-	zigzagAbove                rune = 859  // COMBINING ZIGZAG ABOVE
-	graveAccent                rune = 768  // COMBINING GRAVE ACCENT
-	upwardsArrowBelow          rune = 846  // COMBINING UPWARDS ARROW BELOW
-	tildeBelow                 rune = 816  // COMBINING tilde BELOW
-	turnedCommaAbove           rune = 786  // COMBINING TURNED COMMA ABOVE
-	caron                      rune = 780  // COMBINING caron
-	overline                   rune = 773  // COMBINING overline
-	graphemeJoiner             rune = 847  // COMBINING GRAPHEME JOINER
-	macron                     rune = 772  // COMBINING macron
-	enclosingDiamond           rune = 8415 // COMBINING ENCLOSING DIAMOND
-	circumflexAccentBelow      rune = 813  // COMBINING CIRCUMFLEX ACCENT BELOW
-	asteriskBelow              rune = 857  // COMBINING ASTERISK BELOW
-	doubleBreve                rune = 861  // COMBINING DOUBLE BREVE
-	palatalizedHookBelow       rune = 801  // COMBINING PALATALIZED HOOK BELOW
-	longSolidusOverlay         rune = 824  // COMBINING LONG SOLIDUS OVERLAY
-	doubleMacron               rune = 862  // COMBINING DOUBLE macron
-	macronAcute                rune = 7620 // COMBINING macron-ACUTE
-	graveMacron                rune = 7621 // COMBINING GRAVE-macron
-	verticalTilde              rune = 830  // COMBINING VERTICAL tilde
-	equalSignBelow             rune = 839  // COMBINING EQUALS SIGN BELOW
-	latinSmallLetterX          rune = 879  // COMBINING LATIN SMALL LETTER X
-	clockwiseRingOverlay       rune = 8409 // COMBINING CLOCKWISE RING OVERLAY
-	anticlockwiseRingOverlay   rune = 8410 // COMBINING ANTICLOCKWISE RING OVERLAY
-	verticalLineAbove          rune = 781  // COMBINING VERTICAL LINE ABOVE
-	invertedBreve              rune = 785  // COMBINING INVERTED BREVE
-	commaBelow                 rune = 806  // COMBINING COMMA BELOW
-	cedilla                    rune = 807  // COMBINING cedilla
-	invertedBridgeBelow        rune = 826  // COMBINING INVERTED BRIDGE BELOW
-
 	// Mosavet Characters
-	fatheh              rune = 1614 // Arabic Fatha
-	tashdid             rune = 1617 // Arabic Shadda
-	sokun               rune = 1618 // Arabic Sokun
-	zameh               rune = 1615 // Arabic Damma
-	kasreh              rune = 1616 // Arabic Kasra
-	tanvinZameh         rune = 1612 // Arabic Dammatan
-	tanvinFatheh        rune = 1611 // Arabic Fathatan
-	tanvinKasreh        rune = 1613 // Arabic Kasratan
-	alefLittle          rune = 1648 // Arabic Alef With Hamza Above (Small Alef)
-	arabicTatweel       rune = 'ـ'  // Arabic Tatweel
-	arabicMaddahAbove   rune = 1619 // Arabic Maddah Above
-	arabicSmallYeh      rune = 1766
-	arabicSubscriptAlef rune = 1622 // Arabic Subscript Alef
-
-	// Hamzeh
-	persianHamza rune = 1569 // ARABIC LET­TER HAMZA
-
-	// Arabic Yeh
-	arabicYehWithThreeDotsAbove = '\u063F'
-	arabicYehWithInvertedV      = '\u063D'
-	rtl                         = '\u200F'
-	ltr                         = '\u200E'
-	cc                          = '\uFFFF' // Convert Characters: replace all non-ASCII characters in the file with an escape sequence in the form of \uFFFF
+	fatheh            rune = 1614 // Arabic Fatha
+	tashdid           rune = 1617 // Arabic Shadda
+	sokun             rune = 1618 // Arabic Sokun
+	zameh             rune = 1615 // Arabic Damma
+	kasreh            rune = 1616 // Arabic Kasra
+	tanvinZameh       rune = 1612 // Arabic Dammatan
+	tanvinFatheh      rune = 1611 // Arabic Fathatan
+	tanvinKasreh      rune = 1613 // Arabic Kasratan
+	alefLittle        rune = 1648 // Arabic Alef With Hamza Above (Small Alef)
+	arabicTatweel     rune = 'ـ'  // Arabic Tatweel
+	arabicMaddahAbove rune = 1619 // Arabic Maddah Above
+	arabicSmallYeh    rune = 1766
 
 	// Spaces and Special Characters
 	spaceZeroWidthNonJoiner = '\u200c'
@@ -0,0 +1,59 @@
+package internal
+
+import "unicode"
+
+//go:generate go run gen_chars.go
+
+// charRule is what NormalizeCharacters does with a rune: the action is in the high byte,
+// and the rune or the digit value it uses below it
+type charRule uint32
+
+const (
+	charReplace charRule = 1 << 24 // replace the rune with the rune of the rule
+	charDigit   charRule = 2 << 24 // replace the digit with the digit of the configured language that has its value
+	charStrip   charRule = 3 << 24 // remove the rune
+
+	charActionMask charRule = 0xff << 24
+)
+
+func (c charRule) action() charRule {
+	return c & charActionMask
+}
+
+func (c charRule) value() rune {
+	return rune(c &^ charActionMask)
+}
+
+// charMapping is the rule of a rune
+type charMapping struct {
+	r    rune
+	rule charRule
+}
+
+// charBlocks and charTable form a two-stage lookup table of charMappings. charBlocks gives the index
+// in charTable of every block of 256 runes, and the first block of charTable, which has no rules,
+// is shared by the blocks without any.
+var (
+	charBlocks [(unicode.MaxRune + 1) >> 8]uint8
+	charTable  = [][256]charRule{{}}
+)
+
+func init() {
+	for _, m := range charMappings {
+		block := charBlocks[m.r>>8]
+		if block == 0 {
+			charTable = append(charTable, [256]charRule{})
+			block = uint8(len(charTable) - 1)
+			charBlocks[m.r>>8] = block
+		}
+		charTable[block][m.r&0xff] = m.rule
+	}
+}
+
+// lookupChar returns the rule of r, zero when NormalizeCharacters keeps it as it is
+func lookupChar(r rune) charRule {
+	if r < 0 || r > unicode.MaxRune {
+		return 0
+	}
+	return charTable[charBlocks[r>>8]][r&0xff]
+}
@@ -0,0 +1,1174 @@
+// Code generated by gen_chars.go from Unicode 14.0.0 data. DO NOT EDIT.
+
+package internal
+
+// charMappings are the rules of NormalizeCharacters sorted by rune
+var charMappings = [...]charMapping{
+	{0x0025, charReplace | '٪'}, // PERCENT SIGN
+	{0x002C, charReplace | '،'}, // COMMA
+	{0x002D, charReplace | '_'}, // HYPHEN-MINUS
+	{0x0030, charDigit | 0},     // DIGIT ZERO
+	{0x0031, charDigit | 1},     // DIGIT ONE
+	{0x0032, charDigit | 2},     // DIGIT TWO
+	{0x0033, charDigit | 3},     // DIGIT THREE
+	{0x0034, charDigit | 4},     // DIGIT FOUR
+	{0x0035, charDigit | 5},     // DIGIT FIVE
+	{0x0036, charDigit | 6},     // DIGIT SIX
+	{0x0037, charDigit | 7},     // DIGIT SEVEN
+	{0x0038, charDigit | 8},     // DIGIT EIGHT
+	{0x0039, charDigit | 9},     // DIGIT NINE
+	{0x003B, charReplace | '؛'}, // SEMICOLON
+	{0x003F, charReplace | '؟'}, // QUESTION MARK
+	{0x00AD, charReplace | '_'}, // SOFT HYPHEN
+	{0x00B2, charDigit | 2},     // SUPERSCRIPT TWO
+	{0x00B3, charDigit | 3},     // SUPERSCRIPT THREE
+	{0x00B9, charDigit | 1},     // SUPERSCRIPT ONE
+	{0x0128, charReplace | 'ا'}, // LATIN CAPITAL LETTER I WITH TILDE
+	{0x02D7, charReplace | '_'}, // MODIFIER LETTER MINUS SIGN
+	{0x0300, charStrip},         // COMBINING GRAVE ACCENT
+	{0x0301, charStrip},         // COMBINING ACUTE ACCENT
+	{0x0303, charStrip},         // COMBINING TILDE
+	{0x0304, charStrip},         // COMBINING MACRON
+	{0x0305, charStrip},         // COMBINING OVERLINE
+	{0x0307, charStrip},         // COMBINING DOT ABOVE
+	{0x0308, charStrip},         // COMBINING DIAERESIS
+	{0x030C, charStrip},         // COMBINING CARON
+	{0x030D, charStrip},         // COMBINING VERTICAL LINE ABOVE
+	{0x0310, charStrip},         // COMBINING CANDRABINDU
+	{0x0311, charStrip},         // COMBINING INVERTED BREVE
+	{0x0312, charStrip},         // COMBINING TURNED COMMA ABOVE
+	{0x031A, charStrip},         // COMBINING LEFT ANGLE ABOVE
+	{0x031C, charStrip},         // COMBINING LEFT HALF RING BELOW
+	{0x031D, charStrip},         // COMBINING UP TACK BELOW
+	{0x031F, charStrip},         // COMBINING PLUS SIGN BELOW
+	{0x0321, charStrip},         // COMBINING PALATALIZED HOOK BELOW
+	{0x0323, charStrip},         // COMBINING DOT BELOW
+	{0x0324, charStrip},         // COMBINING DIAERESIS BELOW
+	{0x0325, charStrip},         // COMBINING RING BELOW
+	{0x0326, charStrip},         // COMBINING COMMA BELOW
+	{0x0327, charStrip},         // COMBINING CEDILLA
+	{0x0328, charStrip},         // COMBINING OGONEK
+	{0x0329, charStrip},         // COMBINING VERTICAL LINE BELOW
+	{0x032C, charStrip},         // COMBINING CARON BELOW
+	{0x032D, charStrip},         // COMBINING CIRCUMFLEX ACCENT BELOW
+	{0x032E, charStrip},         // COMBINING BREVE BELOW
+	{0x032F, charStrip},         // COMBINING INVERTED BREVE BELOW
+	{0x0330, charStrip},         // COMBINING TILDE BELOW
+	{0x0332, charStrip},         // COMBINING LOW LINE
+	{0x0336, charStrip},         // COMBINING LONG STROKE OVERLAY
+	{0x0338, charStrip},         // COMBINING LONG SOLIDUS OVERLAY
+	{0x033A, charStrip},         // COMBINING INVERTED BRIDGE BELOW
+	{0x033C, charStrip},         // COMBINING SEAGULL BELOW
+	{0x033E, charStrip},         // COMBINING VERTICAL TILDE
+	{0x0347, charStrip},         // COMBINING EQUALS SIGN BELOW
+	{0x034E, charStrip},         // COMBINING UPWARDS ARROW BELOW
+	{0x034F, charStrip},         // COMBINING GRAPHEME JOINER
+	{0x0352, charStrip},         // COMBINING FERMATA
+	{0x0359, charStrip},         // COMBINING ASTERISK BELOW
+	{0x035B, charStrip},         // COMBINING ZIGZAG ABOVE
+	{0x035C, charStrip},         // COMBINING DOUBLE BREVE BELOW
+	{0x035D, charStrip},         // COMBINING DOUBLE BREVE
+	{0x035E, charStrip},         // COMBINING DOUBLE MACRON
+	{0x035F, charStrip},         // COMBINING DOUBLE MACRON BELOW
+	{0x0362, charStrip},         // COMBINING DOUBLE RIGHTWARDS ARROW BELOW
+	{0x036F, charStrip},         // COMBINING LATIN SMALL LETTER X
+	{0x0577, charDigit | 2},     // ARMENIAN SMALL LETTER SHA
+	{0x05BE, charReplace | '_'}, // HEBREW PUNCTUATION MAQAF
+	{0x060B, charReplace | 'ف'}, // AFGHANI SIGN
+	{0x060F, charReplace | 'ع'}, // ARABIC SIGN MISRA
+	{0x0621, charStrip},         // ARABIC LETTER HAMZA
+	{0x0622, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH MADDA ABOVE
+	{0x0623, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA ABOVE
+	{0x0624, charReplace | 'و'}, // ARABIC LETTER WAW WITH HAMZA ABOVE
+	{0x0625, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA BELOW
+	{0x0626, charReplace | 'ی'}, // ARABIC LETTER YEH WITH HAMZA ABOVE
+	{0x0629, charReplace | 'ه'}, // ARABIC LETTER TEH MARBUTA
+	{0x063B, charReplace | 'ک'}, // ARABIC LETTER KEHEH WITH TWO DOTS ABOVE
+	{0x063D, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH WITH INVERTED V
+	{0x063F, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE
+	{0x0640, charReplace | '_'}, // ARABIC TATWEEL
+	{0x0643, charReplace | 'ک'}, // ARABIC LETTER KAF
+	{0x0649, charReplace | 'ی'}, // ARABIC LETTER ALEF MAKSURA
+	{0x064A, charReplace | 'ی'}, // ARABIC LETTER YEH
+	{0x064B, charStrip},         // ARABIC FATHATAN
+	{0x064C, charStrip},         // ARABIC DAMMATAN
+	{0x064D, charStrip},         // ARABIC KASRATAN
+	{0x064E, charStrip},         // ARABIC FATHA
+	{0x064F, charStrip},         // ARABIC DAMMA
+	{0x0650, charStrip},         // ARABIC KASRA
+	{0x0651, charStrip},         // ARABIC SHADDA
+	{0x0652, charStrip},         // ARABIC SUKUN
+	{0x0654, charStrip},         // ARABIC HAMZA ABOVE
+	{0x0656, charStrip},         // ARABIC SUBSCRIPT ALEF
+	{0x0660, charDigit | 0},     // ARABIC-INDIC DIGIT ZERO
+	{0x0661, charDigit | 1},     // ARABIC-INDIC DIGIT ONE
+	{0x0662, charDigit | 2},     // ARABIC-INDIC DIGIT TWO
+	{0x0663, charDigit | 3},     // ARABIC-INDIC DIGIT THREE
+	{0x0664, charDigit | 4},     // ARABIC-INDIC DIGIT FOUR
+	{0x0665, charDigit | 5},     // ARABIC-INDIC DIGIT FIVE
+	{0x0666, charDigit | 6},     // ARABIC-INDIC DIGIT SIX
+	{0x0667, charDigit | 7},     // ARABIC-INDIC DIGIT SEVEN
+	{0x0668, charDigit | 8},     // ARABIC-INDIC DIGIT EIGHT
+	{0x0669, charDigit | 9},     // ARABIC-INDIC DIGIT NINE
+	{0x066C, charReplace | '،'}, // ARABIC THOUSANDS SEPARATOR
+	{0x066E, charReplace | 'ب'}, // ARABIC LETTER DOTLESS BEH
+	{0x0670, charStrip},         // ARABIC LETTER SUPERSCRIPT ALEF
+	{0x0671, charReplace | 'ا'}, // ARABIC LETTER ALEF WASLA
+	{0x0672, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE
+	{0x0674, charStrip},         // ARABIC LETTER HIGH HAMZA
+	{0x0675, charReplace | 'ا'}, // ARABIC LETTER HIGH HAMZA ALEF
+	{0x0676, charReplace | 'و'}, // ARABIC LETTER HIGH HAMZA WAW
+	{0x0677, charReplace | 'و'}, // ARABIC LETTER U WITH HAMZA ABOVE
+	{0x0678, charReplace | 'ی'}, // ARABIC LETTER HIGH HAMZA YEH
+	{0x0679, charReplace | 'ت'}, // ARABIC LETTER TTEH
+	{0x067A, charReplace | 'ت'}, // ARABIC LETTER TTEHEH
+	{0x067C, charReplace | 'ت'}, // ARABIC LETTER TEH WITH RING
+	{0x067D, charReplace | 'ث'}, // ARABIC LETTER TEH WITH THREE DOTS ABOVE DOWNWARDS
+	{0x067F, charReplace | 'ت'}, // ARABIC LETTER TEHEH
+	{0x0681, charReplace | 'ح'}, // ARABIC LETTER HAH WITH HAMZA ABOVE
+	{0x0682, charReplace | 'خ'}, // ARABIC LETTER HAH WITH TWO DOTS VERTICAL ABOVE
+	{0x0683, charReplace | 'ج'}, // ARABIC LETTER NYEH
+	{0x0687, charReplace | 'چ'}, // ARABIC LETTER TCHEHEH
+	{0x0688, charReplace | 'د'}, // ARABIC LETTER DDAL
+	{0x0689, charReplace | 'د'}, // ARABIC LETTER DAL WITH RING
+	{0x068A, charReplace | 'د'}, // ARABIC LETTER DAL WITH DOT BELOW
+	{0x068B, charReplace | 'د'}, // ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH
+	{0x068C, charReplace | 'د'}, // ARABIC LETTER DAHAL
+	{0x068D, charReplace | 'د'}, // ARABIC LETTER DDAHAL
+	{0x0690, charReplace | 'د'}, // ARABIC LETTER DAL WITH FOUR DOTS ABOVE
+	{0x0691, charReplace | 'ر'}, // ARABIC LETTER RREH
+	{0x0692, charReplace | 'ر'}, // ARABIC LETTER REH WITH SMALL V
+	{0x0693, charReplace | 'ر'}, // ARABIC LETTER REH WITH RING
+	{0x0694, charReplace | 'ر'}, // ARABIC LETTER REH WITH DOT BELOW
+	{0x0695, charReplace | 'ر'}, // ARABIC LETTER REH WITH SMALL V BELOW
+	{0x0696, charReplace | 'ر'}, // ARABIC LETTER REH WITH DOT BELOW AND DOT ABOVE
+	{0x0697, charReplace | 'ز'}, // ARABIC LETTER REH WITH TWO DOTS ABOVE
+	{0x069A, charReplace | 'س'}, // ARABIC LETTER SEEN WITH DOT BELOW AND DOT ABOVE
+	{0x069B, charReplace | 'س'}, // ARABIC LETTER SEEN WITH THREE DOTS BELOW
+	{0x069C, charReplace | 'ش'}, // ARABIC LETTER SEEN WITH THREE DOTS BELOW AND THREE DOTS ABOVE
+	{0x069D, charReplace | 'ص'}, // ARABIC LETTER SAD WITH TWO DOTS BELOW
+	{0x069F, charReplace | 'ظ'}, // ARABIC LETTER TAH WITH THREE DOTS ABOVE
+	{0x06A0, charReplace | 'ع'}, // ARABIC LETTER AIN WITH THREE DOTS ABOVE
+	{0x06A2, charReplace | 'ف'}, // ARABIC LETTER FEH WITH DOT MOVED BELOW
+	{0x06A3, charReplace | 'ف'}, // ARABIC LETTER FEH WITH DOT BELOW
+	{0x06A4, charReplace | 'ف'}, // ARABIC LETTER VEH
+	{0x06A5, charReplace | 'ف'}, // ARABIC LETTER FEH WITH THREE DOTS BELOW
+	{0x06A6, charReplace | 'ق'}, // ARABIC LETTER PEHEH
+	{0x06A7, charReplace | 'ق'}, // ARABIC LETTER QAF WITH DOT ABOVE
+	{0x06A8, charReplace | 'ق'}, // ARABIC LETTER QAF WITH THREE DOTS ABOVE
+	{0x06AA, charReplace | 'ک'}, // ARABIC LETTER SWASH KAF
+	{0x06AB, charReplace | 'ک'}, // ARABIC LETTER KAF WITH RING
+	{0x06AC, charReplace | 'ک'}, // ARABIC LETTER KAF WITH DOT ABOVE
+	{0x06AD, charReplace | 'ک'}, // ARABIC LETTER NG
+	{0x06AE, charReplace | 'ک'}, // ARABIC LETTER KAF WITH THREE DOTS BELOW
+	{0x06B0, charReplace | 'گ'}, // ARABIC LETTER GAF WITH RING
+	{0x06B1, charReplace | 'گ'}, // ARABIC LETTER NGOEH
+	{0x06B2, charReplace | 'گ'}, // ARABIC LETTER GAF WITH TWO DOTS BELOW
+	{0x06B3, charReplace | 'گ'}, // ARABIC LETTER GUEH
+	{0x06B4, charReplace | 'گ'}, // ARABIC LETTER GAF WITH THREE DOTS ABOVE
+	{0x06B5, charReplace | 'ل'}, // ARABIC LETTER LAM WITH SMALL V
+	{0x06B6, charReplace | 'ل'}, // ARABIC LETTER LAM WITH DOT ABOVE
+	{0x06B7, charReplace | 'ل'}, // ARABIC LETTER LAM WITH THREE DOTS ABOVE
+	{0x06B8, charReplace | 'ل'}, // ARABIC LETTER LAM WITH THREE DOTS BELOW
+	{0x06B9, charReplace | 'ن'}, // ARABIC LETTER NOON WITH DOT BELOW
+	{0x06BA, charReplace | 'ن'}, // ARABIC LETTER NOON GHUNNA
+	{0x06BB, charReplace | 'ن'}, // ARABIC LETTER RNOON
+	{0x06BE, charReplace | 'ه'}, // ARABIC LETTER HEH DOACHASHMEE
+	{0x06BF, charReplace | 'چ'}, // ARABIC LETTER TCHEH WITH DOT ABOVE
+	{0x06C0, charReplace | 'ه'}, // ARABIC LETTER HEH WITH YEH ABOVE
+	{0x06C1, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL
+	{0x06C2, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL WITH HAMZA ABOVE
+	{0x06C3, charReplace | 'ه'}, // ARABIC LETTER TEH MARBUTA GOAL
+	{0x06C4, charReplace | 'و'}, // ARABIC LETTER WAW WITH RING
+	{0x06C5, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ OE
+	{0x06C6, charReplace | 'و'}, // ARABIC LETTER OE
+	{0x06C7, charReplace | 'و'}, // ARABIC LETTER U
+	{0x06C8, charReplace | 'و'}, // ARABIC LETTER YU
+	{0x06C9, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ YU
+	{0x06CA, charReplace | 'و'}, // ARABIC LETTER WAW WITH TWO DOTS ABOVE
+	{0x06CB, charReplace | 'و'}, // ARABIC LETTER VE
+	{0x06CD, charReplace | 'ی'}, // ARABIC LETTER YEH WITH TAIL
+	{0x06CF, charReplace | 'و'}, // ARABIC LETTER WAW WITH DOT ABOVE
+	{0x06D0, charReplace | 'ی'}, // ARABIC LETTER E
+	{0x06D1, charReplace | 'ی'}, // ARABIC LETTER YEH WITH THREE DOTS BELOW
+	{0x06D2, charReplace | 'ی'}, // ARABIC LETTER YEH BARREE
+	{0x06D5, charReplace | 'ه'}, // ARABIC LETTER AE
+	{0x06EE, charReplace | 'د'}, // ARABIC LETTER DAL WITH INVERTED V
+	{0x06EF, charReplace | 'ر'}, // ARABIC LETTER REH WITH INVERTED V
+	{0x06F0, charDigit | 0},     // EXTENDED ARABIC-INDIC DIGIT ZERO
+	{0x06F1, charDigit | 1},     // EXTENDED ARABIC-INDIC DIGIT ONE
+	{0x06F2, charDigit | 2},     // EXTENDED ARABIC-INDIC DIGIT TWO
+	{0x06F3, charDigit | 3},     // EXTENDED ARABIC-INDIC DIGIT THREE
+	{0x06F4, charDigit | 4},     // EXTENDED ARABIC-INDIC DIGIT FOUR
+	{0x06F5, charDigit | 5},     // EXTENDED ARABIC-INDIC DIGIT FIVE
+	{0x06F6, charDigit | 6},     // EXTENDED ARABIC-INDIC DIGIT SIX
+	{0x06F7, charDigit | 7},     // EXTENDED ARABIC-INDIC DIGIT SEVEN
+	{0x06F8, charDigit | 8},     // EXTENDED ARABIC-INDIC DIGIT EIGHT
+	{0x06F9, charDigit | 9},     // EXTENDED ARABIC-INDIC DIGIT NINE
+	{0x06FA, charReplace | 'ش'}, // ARABIC LETTER SHEEN WITH DOT BELOW
+	{0x06FB, charReplace | 'ض'}, // ARABIC LETTER DAD WITH DOT BELOW
+	{0x06FC, charReplace | 'غ'}, // ARABIC LETTER GHAIN WITH DOT BELOW
+	{0x06FD, charStrip},         // ARABIC SIGN SINDHI AMPERSAND
+	{0x06FE, charReplace | 'م'}, // ARABIC SIGN SINDHI POSTPOSITION MEN
+	{0x06FF, charReplace | 'ه'}, // ARABIC LETTER HEH WITH INVERTED V
+	{0x0750, charReplace | 'پ'}, // ARABIC LETTER BEH WITH THREE DOTS HORIZONTALLY BELOW
+	{0x0751, charReplace | 'ث'}, // ARABIC LETTER BEH WITH DOT BELOW AND THREE DOTS ABOVE
+	{0x0752, charReplace | 'پ'}, // ARABIC LETTER BEH WITH THREE DOTS POINTING UPWARDS BELOW
+	{0x0753, charReplace | 'ت'}, // ARABIC LETTER BEH WITH THREE DOTS POINTING UPWARDS BELOW AND TWO DOTS ABOVE
+	{0x0755, charReplace | 'ب'}, // ARABIC LETTER BEH WITH INVERTED SMALL V BELOW
+	{0x0757, charReplace | 'خ'}, // ARABIC LETTER HAH WITH TWO DOTS ABOVE
+	{0x0758, charReplace | 'چ'}, // ARABIC LETTER HAH WITH THREE DOTS POINTING UPWARDS BELOW
+	{0x075B, charReplace | 'ر'}, // ARABIC LETTER REH WITH STROKE
+	{0x075C, charReplace | 'ش'}, // ARABIC LETTER SEEN WITH FOUR DOTS ABOVE
+	{0x075E, charReplace | 'غ'}, // ARABIC LETTER AIN WITH THREE DOTS POINTING DOWNWARDS ABOVE
+	{0x075F, charReplace | 'غ'}, // ARABIC LETTER AIN WITH TWO DOTS VERTICALLY ABOVE
+	{0x0762, charReplace | 'ک'}, // ARABIC LETTER KEHEH WITH DOT ABOVE
+	{0x0763, charReplace | 'ک'}, // ARABIC LETTER KEHEH WITH THREE DOTS ABOVE
+	{0x0764, charReplace | 'ک'}, // ARABIC LETTER KEHEH WITH THREE DOTS POINTING UPWARDS BELOW
+	{0x0765, charReplace | 'م'}, // ARABIC LETTER MEEM WITH DOT ABOVE
+	{0x0767, charReplace | 'ن'}, // ARABIC LETTER NOON WITH TWO DOTS BELOW
+	{0x076A, charReplace | 'ل'}, // ARABIC LETTER LAM WITH BAR
+	{0x076B, charReplace | 'ز'}, // ARABIC LETTER REH WITH TWO DOTS VERTICALLY ABOVE
+	{0x076C, charReplace | 'ر'}, // ARABIC LETTER REH WITH HAMZA ABOVE
+	{0x076D, charReplace | 'س'}, // ARABIC LETTER SEEN WITH TWO DOTS VERTICALLY ABOVE
+	{0x0788, charReplace | 'و'}, // THAANA LETTER VAAVU
+	{0x07C0, charDigit | 0},     // NKO DIGIT ZERO
+	{0x07C1, charDigit | 1},     // NKO DIGIT ONE
+	{0x07C2, charDigit | 2},     // NKO DIGIT TWO
+	{0x07C3, charDigit | 3},     // NKO DIGIT THREE
+	{0x07C4, charDigit | 4},     // NKO DIGIT FOUR
+	{0x07C5, charDigit | 5},     // NKO DIGIT FIVE
+	{0x07C6, charDigit | 6},     // NKO DIGIT SIX
+	{0x07C7, charDigit | 7},     // NKO DIGIT SEVEN
+	{0x07C8, charDigit | 8},     // NKO DIGIT EIGHT
+	{0x07C9, charDigit | 9},     // NKO DIGIT NINE
+	{0x0966, charDigit | 0},     // DEVANAGARI DIGIT ZERO
+	{0x0967, charDigit | 1},     // DEVANAGARI DIGIT ONE
+	{0x0968, charDigit | 2},     // DEVANAGARI DIGIT TWO
+	{0x0969, charDigit | 3},     // DEVANAGARI DIGIT THREE
+	{0x096A, charDigit | 4},     // DEVANAGARI DIGIT FOUR
+	{0x096B, charDigit | 5},     // DEVANAGARI DIGIT FIVE
+	{0x096C, charDigit | 6},     // DEVANAGARI DIGIT SIX
+	{0x096D, charDigit | 7},     // DEVANAGARI DIGIT SEVEN
+	{0x096E, charDigit | 8},     // DEVANAGARI DIGIT EIGHT
+	{0x096F, charDigit | 9},     // DEVANAGARI DIGIT NINE
+	{0x09E6, charDigit | 0},     // BENGALI DIGIT ZERO
+	{0x09E7, charDigit | 1},     // BENGALI DIGIT ONE
+	{0x09E8, charDigit | 2},     // BENGALI DIGIT TWO
+	{0x09E9, charDigit | 3},     // BENGALI DIGIT THREE
+	{0x09EA, charDigit | 4},     // BENGALI DIGIT FOUR
+	{0x09EB, charDigit | 5},     // BENGALI DIGIT FIVE
+	{0x09EC, charDigit | 6},     // BENGALI DIGIT SIX
+	{0x09ED, charDigit | 7},     // BENGALI DIGIT SEVEN
+	{0x09EE, charDigit | 8},     // BENGALI DIGIT EIGHT
+	{0x09EF, charDigit | 9},     // BENGALI DIGIT NINE
+	{0x0A66, charDigit | 0},     // GURMUKHI DIGIT ZERO
+	{0x0A67, charDigit | 1},     // GURMUKHI DIGIT ONE
+	{0x0A68, charDigit | 2},     // GURMUKHI DIGIT TWO
+	{0x0A69, charDigit | 3},     // GURMUKHI DIGIT THREE
+	{0x0A6A, charDigit | 4},     // GURMUKHI DIGIT FOUR
+	{0x0A6B, charDigit | 5},     // GURMUKHI DIGIT FIVE
+	{0x0A6C, charDigit | 6},     // GURMUKHI DIGIT SIX
+	{0x0A6D, charDigit | 7},     // GURMUKHI DIGIT SEVEN
+	{0x0A6E, charDigit | 8},     // GURMUKHI DIGIT EIGHT
+	{0x0A6F, charDigit | 9},     // GURMUKHI DIGIT NINE
+	{0x0AE6, charDigit | 0},     // GUJARATI DIGIT ZERO
+	{0x0AE7, charDigit | 1},     // GUJARATI DIGIT ONE
+	{0x0AE8, charDigit | 2},     // GUJARATI DIGIT TWO
+	{0x0AE9, charDigit | 3},     // GUJARATI DIGIT THREE
+	{0x0AEA, charDigit | 4},     // GUJARATI DIGIT FOUR
+	{0x0AEB, charDigit | 5},     // GUJARATI DIGIT FIVE
+	{0x0AEC, charDigit | 6},     // GUJARATI DIGIT SIX
+	{0x0AED, charDigit | 7},     // GUJARATI DIGIT SEVEN
+	{0x0AEE, charDigit | 8},     // GUJARATI DIGIT EIGHT
+	{0x0AEF, charDigit | 9},     // GUJARATI DIGIT NINE
+	{0x0B66, charDigit | 0},     // ORIYA DIGIT ZERO
+	{0x0B67, charDigit | 1},     // ORIYA DIGIT ONE
+	{0x0B68, charDigit | 2},     // ORIYA DIGIT TWO
+	{0x0B69, charDigit | 3},     // ORIYA DIGIT THREE
+	{0x0B6A, charDigit | 4},     // ORIYA DIGIT FOUR
+	{0x0B6B, charDigit | 5},     // ORIYA DIGIT FIVE
+	{0x0B6C, charDigit | 6},     // ORIYA DIGIT SIX
+	{0x0B6D, charDigit | 7},     // ORIYA DIGIT SEVEN
+	{0x0B6E, charDigit | 8},     // ORIYA DIGIT EIGHT
+	{0x0B6F, charDigit | 9},     // ORIYA DIGIT NINE
+	{0x0BE6, charDigit | 0},     // TAMIL DIGIT ZERO
+	{0x0BE7, charDigit | 1},     // TAMIL DIGIT ONE
+	{0x0BE8, charDigit | 2},     // TAMIL DIGIT TWO
+	{0x0BE9, charDigit | 3},     // TAMIL DIGIT THREE
+	{0x0BEA, charDigit | 4},     // TAMIL DIGIT FOUR
+	{0x0BEB, charDigit | 5},     // TAMIL DIGIT FIVE
+	{0x0BEC, charDigit | 6},     // TAMIL DIGIT SIX
+	{0x0BED, charDigit | 7},     // TAMIL DIGIT SEVEN
+	{0x0BEE, charDigit | 8},     // TAMIL DIGIT EIGHT
+	{0x0BEF, charDigit | 9},     // TAMIL DIGIT NINE
+	{0x0C66, charDigit | 0},     // TELUGU DIGIT ZERO
+	{0x0C67, charDigit | 1},     // TELUGU DIGIT ONE
+	{0x0C68, charDigit | 2},     // TELUGU DIGIT TWO
+	{0x0C69, charDigit | 3},     // TELUGU DIGIT THREE
+	{0x0C6A, charDigit | 4},     // TELUGU DIGIT FOUR
+	{0x0C6B, charDigit | 5},     // TELUGU DIGIT FIVE
+	{0x0C6C, charDigit | 6},     // TELUGU DIGIT SIX
+	{0x0C6D, charDigit | 7},     // TELUGU DIGIT SEVEN
+	{0x0C6E, charDigit | 8},     // TELUGU DIGIT EIGHT
+	{0x0C6F, charDigit | 9},     // TELUGU DIGIT NINE
+	{0x0CE6, charDigit | 0},     // KANNADA DIGIT ZERO
+	{0x0CE7, charDigit | 1},     // KANNADA DIGIT ONE
+	{0x0CE8, charDigit | 2},     // KANNADA DIGIT TWO
+	{0x0CE9, charDigit | 3},     // KANNADA DIGIT THREE
+	{0x0CEA, charDigit | 4},     // KANNADA DIGIT FOUR
+	{0x0CEB, charDigit | 5},     // KANNADA DIGIT FIVE
+	{0x0CEC, charDigit | 6},     // KANNADA DIGIT SIX
+	{0x0CED, charDigit | 7},     // KANNADA DIGIT SEVEN
+	{0x0CEE, charDigit | 8},     // KANNADA DIGIT EIGHT
+	{0x0CEF, charDigit | 9},     // KANNADA DIGIT NINE
+	{0x0D66, charDigit | 0},     // MALAYALAM DIGIT ZERO
+	{0x0D67, charDigit | 1},     // MALAYALAM DIGIT ONE
+	{0x0D68, charDigit | 2},     // MALAYALAM DIGIT TWO
+	{0x0D69, charDigit | 3},     // MALAYALAM DIGIT THREE
+	{0x0D6A, charDigit | 4},     // MALAYALAM DIGIT FOUR
+	{0x0D6B, charDigit | 5},     // MALAYALAM DIGIT FIVE
+	{0x0D6C, charDigit | 6},     // MALAYALAM DIGIT SIX
+	{0x0D6D, charDigit | 7},     // MALAYALAM DIGIT SEVEN
+	{0x0D6E, charDigit | 8},     // MALAYALAM DIGIT EIGHT
+	{0x0D6F, charDigit | 9},     // MALAYALAM DIGIT NINE
+	{0x0DE6, charDigit | 0},     // SINHALA LITH DIGIT ZERO
+	{0x0DE7, charDigit | 1},     // SINHALA LITH DIGIT ONE
+	{0x0DE8, charDigit | 2},     // SINHALA LITH DIGIT TWO
+	{0x0DE9, charDigit | 3},     // SINHALA LITH DIGIT THREE
+	{0x0DEA, charDigit | 4},     // SINHALA LITH DIGIT FOUR
+	{0x0DEB, charDigit | 5},     // SINHALA LITH DIGIT FIVE
+	{0x0DEC, charDigit | 6},     // SINHALA LITH DIGIT SIX
+	{0x0DED, charDigit | 7},     // SINHALA LITH DIGIT SEVEN
+	{0x0DEE, charDigit | 8},     // SINHALA LITH DIGIT EIGHT
+	{0x0DEF, charDigit | 9},     // SINHALA LITH DIGIT NINE
+	{0x0E50, charDigit | 0},     // THAI DIGIT ZERO
+	{0x0E51, charDigit | 1},     // THAI DIGIT ONE
+	{0x0E52, charDigit | 2},     // THAI DIGIT TWO
+	{0x0E53, charDigit | 3},     // THAI DIGIT THREE
+	{0x0E54, charDigit | 4},     // THAI DIGIT FOUR
+	{0x0E55, charDigit | 5},     // THAI DIGIT FIVE
+	{0x0E56, charDigit | 6},     // THAI DIGIT SIX
+	{0x0E57, charDigit | 7},     // THAI DIGIT SEVEN
+	{0x0E58, charDigit | 8},     // THAI DIGIT EIGHT
+	{0x0E59, charDigit | 9},     // THAI DIGIT NINE
+	{0x0ED0, charDigit | 0},     // LAO DIGIT ZERO
+	{0x0ED1, charDigit | 1},     // LAO DIGIT ONE
+	{0x0ED2, charDigit | 2},     // LAO DIGIT TWO
+	{0x0ED3, charDigit | 3},     // LAO DIGIT THREE
+	{0x0ED4, charDigit | 4},     // LAO DIGIT FOUR
+	{0x0ED5, charDigit | 5},     // LAO DIGIT FIVE
+	{0x0ED6, charDigit | 6},     // LAO DIGIT SIX
+	{0x0ED7, charDigit | 7},     // LAO DIGIT SEVEN
+	{0x0ED8, charDigit | 8},     // LAO DIGIT EIGHT
+	{0x0ED9, charDigit | 9},     // LAO DIGIT NINE
+	{0x0F20, charDigit | 0},     // TIBETAN DIGIT ZERO
+	{0x0F21, charDigit | 1},     // TIBETAN DIGIT ONE
+	{0x0F22, charDigit | 2},     // TIBETAN DIGIT TWO
+	{0x0F23, charDigit | 3},     // TIBETAN DIGIT THREE
+	{0x0F24, charDigit | 4},     // TIBETAN DIGIT FOUR
+	{0x0F25, charDigit | 5},     // TIBETAN DIGIT FIVE
+	{0x0F26, charDigit | 6},     // TIBETAN DIGIT SIX
+	{0x0F27, charDigit | 7},     // TIBETAN DIGIT SEVEN
+	{0x0F28, charDigit | 8},     // TIBETAN DIGIT EIGHT
+	{0x0F29, charDigit | 9},     // TIBETAN DIGIT NINE
+	{0x1040, charDigit | 0},     // MYANMAR DIGIT ZERO
+	{0x1041, charDigit | 1},     // MYANMAR DIGIT ONE
+	{0x1042, charDigit | 2},     // MYANMAR DIGIT TWO
+	{0x1043, charDigit | 3},     // MYANMAR DIGIT THREE
+	{0x1044, charDigit | 4},     // MYANMAR DIGIT FOUR
+	{0x1045, charDigit | 5},     // MYANMAR DIGIT FIVE
+	{0x1046, charDigit | 6},     // MYANMAR DIGIT SIX
+	{0x1047, charDigit | 7},     // MYANMAR DIGIT SEVEN
+	{0x1048, charDigit | 8},     // MYANMAR DIGIT EIGHT
+	{0x1049, charDigit | 9},     // MYANMAR DIGIT NINE
+	{0x1090, charDigit | 0},     // MYANMAR SHAN DIGIT ZERO
+	{0x1091, charDigit | 1},     // MYANMAR SHAN DIGIT ONE
+	{0x1092, charDigit | 2},     // MYANMAR SHAN DIGIT TWO
+	{0x1093, charDigit | 3},     // MYANMAR SHAN DIGIT THREE
+	{0x1094, charDigit | 4},     // MYANMAR SHAN DIGIT FOUR
+	{0x1095, charDigit | 5},     // MYANMAR SHAN DIGIT FIVE
+	{0x1096, charDigit | 6},     // MYANMAR SHAN DIGIT SIX
+	{0x1097, charDigit | 7},     // MYANMAR SHAN DIGIT SEVEN
+	{0x1098, charDigit | 8},     // MYANMAR SHAN DIGIT EIGHT
+	{0x1099, charDigit | 9},     // MYANMAR SHAN DIGIT NINE
+	{0x14C5, charReplace | 'ف'}, // CANADIAN SYLLABICS NOO
+	{0x17E0, charDigit | 0},     // KHMER DIGIT ZERO
+	{0x17E1, charDigit | 1},     // KHMER DIGIT ONE
+	{0x17E2, charDigit | 2},     // KHMER DIGIT TWO
+	{0x17E3, charDigit | 3},     // KHMER DIGIT THREE
+	{0x17E4, charDigit | 4},     // KHMER DIGIT FOUR
+	{0x17E5, charDigit | 5},     // KHMER DIGIT FIVE
+	{0x17E6, charDigit | 6},     // KHMER DIGIT SIX
+	{0x17E7, charDigit | 7},     // KHMER DIGIT SEVEN
+	{0x17E8, charDigit | 8},     // KHMER DIGIT EIGHT
+	{0x17E9, charDigit | 9},     // KHMER DIGIT NINE
+	{0x1810, charDigit | 0},     // MONGOLIAN DIGIT ZERO
+	{0x1811, charDigit | 1},     // MONGOLIAN DIGIT ONE
+	{0x1812, charDigit | 2},     // MONGOLIAN DIGIT TWO
+	{0x1813, charDigit | 3},     // MONGOLIAN DIGIT THREE
+	{0x1814, charDigit | 4},     // MONGOLIAN DIGIT FOUR
+	{0x1815, charDigit | 5},     // MONGOLIAN DIGIT FIVE
+	{0x1816, charDigit | 6},     // MONGOLIAN DIGIT SIX
+	{0x1817, charDigit | 7},     // MONGOLIAN DIGIT SEVEN
+	{0x1818, charDigit | 8},     // MONGOLIAN DIGIT EIGHT
+	{0x1819, charDigit | 9},     // MONGOLIAN DIGIT NINE
+	{0x1946, charDigit | 0},     // LIMBU DIGIT ZERO
+	{0x1947, charDigit | 1},     // LIMBU DIGIT ONE
+	{0x1948, charDigit | 2},     // LIMBU DIGIT TWO
+	{0x1949, charDigit | 3},     // LIMBU DIGIT THREE
+	{0x194A, charDigit | 4},     // LIMBU DIGIT FOUR
+	{0x194B, charDigit | 5},     // LIMBU DIGIT FIVE
+	{0x194C, charDigit | 6},     // LIMBU DIGIT SIX
+	{0x194D, charDigit | 7},     // LIMBU DIGIT SEVEN
+	{0x194E, charDigit | 8},     // LIMBU DIGIT EIGHT
+	{0x194F, charDigit | 9},     // LIMBU DIGIT NINE
+	{0x19D0, charDigit | 0},     // NEW TAI LUE DIGIT ZERO
+	{0x19D1, charDigit | 1},     // NEW TAI LUE DIGIT ONE
+	{0x19D2, charDigit | 2},     // NEW TAI LUE DIGIT TWO
+	{0x19D3, charDigit | 3},     // NEW TAI LUE DIGIT THREE
+	{0x19D4, charDigit | 4},     // NEW TAI LUE DIGIT FOUR
+	{0x19D5, charDigit | 5},     // NEW TAI LUE DIGIT FIVE
+	{0x19D6, charDigit | 6},     // NEW TAI LUE DIGIT SIX
+	{0x19D7, charDigit | 7},     // NEW TAI LUE DIGIT SEVEN
+	{0x19D8, charDigit | 8},     // NEW TAI LUE DIGIT EIGHT
+	{0x19D9, charDigit | 9},     // NEW TAI LUE DIGIT NINE
+	{0x1A80, charDigit | 0},     // TAI THAM HORA DIGIT ZERO
+	{0x1A81, charDigit | 1},     // TAI THAM HORA DIGIT ONE
+	{0x1A82, charDigit | 2},     // TAI THAM HORA DIGIT TWO
+	{0x1A83, charDigit | 3},     // TAI THAM HORA DIGIT THREE
+	{0x1A84, charDigit | 4},     // TAI THAM HORA DIGIT FOUR
+	{0x1A85, charDigit | 5},     // TAI THAM HORA DIGIT FIVE
+	{0x1A86, charDigit | 6},     // TAI THAM HORA DIGIT SIX
+	{0x1A87, charDigit | 7},     // TAI THAM HORA DIGIT SEVEN
+	{0x1A88, charDigit | 8},     // TAI THAM HORA DIGIT EIGHT
+	{0x1A89, charDigit | 9},     // TAI THAM HORA DIGIT NINE
+	{0x1A90, charDigit | 0},     // TAI THAM THAM DIGIT ZERO
+	{0x1A91, charDigit | 1},     // TAI THAM THAM DIGIT ONE
+	{0x1A92, charDigit | 2},     // TAI THAM THAM DIGIT TWO
+	{0x1A93, charDigit | 3},     // TAI THAM THAM DIGIT THREE
+	{0x1A94, charDigit | 4},     // TAI THAM THAM DIGIT FOUR
+	{0x1A95, charDigit | 5},     // TAI THAM THAM DIGIT FIVE
+	{0x1A96, charDigit | 6},     // TAI THAM THAM DIGIT SIX
+	{0x1A97, charDigit | 7},     // TAI THAM THAM DIGIT SEVEN
+	{0x1A98, charDigit | 8},     // TAI THAM THAM DIGIT EIGHT
+	{0x1A99, charDigit | 9},     // TAI THAM THAM DIGIT NINE
+	{0x1B50, charDigit | 0},     // BALINESE DIGIT ZERO
+	{0x1B51, charDigit | 1},     // BALINESE DIGIT ONE
+	{0x1B52, charDigit | 2},     // BALINESE DIGIT TWO
+	{0x1B53, charDigit | 3},     // BALINESE DIGIT THREE
+	{0x1B54, charDigit | 4},     // BALINESE DIGIT FOUR
+	{0x1B55, charDigit | 5},     // BALINESE DIGIT FIVE
+	{0x1B56, charDigit | 6},     // BALINESE DIGIT SIX
+	{0x1B57, charDigit | 7},     // BALINESE DIGIT SEVEN
+	{0x1B58, charDigit | 8},     // BALINESE DIGIT EIGHT
+	{0x1B59, charDigit | 9},     // BALINESE DIGIT NINE
+	{0x1BB0, charDigit | 0},     // SUNDANESE DIGIT ZERO
+	{0x1BB1, charDigit | 1},     // SUNDANESE DIGIT ONE
+	{0x1BB2, charDigit | 2},     // SUNDANESE DIGIT TWO
+	{0x1BB3, charDigit | 3},     // SUNDANESE DIGIT THREE
+	{0x1BB4, charDigit | 4},     // SUNDANESE DIGIT FOUR
+	{0x1BB5, charDigit | 5},     // SUNDANESE DIGIT FIVE
+	{0x1BB6, charDigit | 6},     // SUNDANESE DIGIT SIX
+	{0x1BB7, charDigit | 7},     // SUNDANESE DIGIT SEVEN
+	{0x1BB8, charDigit | 8},     // SUNDANESE DIGIT EIGHT
+	{0x1BB9, charDigit | 9},     // SUNDANESE DIGIT NINE
+	{0x1C40, charDigit | 0},     // LEPCHA DIGIT ZERO
+	{0x1C41, charDigit | 1},     // LEPCHA DIGIT ONE
+	{0x1C42, charDigit | 2},     // LEPCHA DIGIT TWO
+	{0x1C43, charDigit | 3},     // LEPCHA DIGIT THREE
+	{0x1C44, charDigit | 4},     // LEPCHA DIGIT FOUR
+	{0x1C45, charDigit | 5},     // LEPCHA DIGIT FIVE
+	{0x1C46, charDigit | 6},     // LEPCHA DIGIT SIX
+	{0x1C47, charDigit | 7},     // LEPCHA DIGIT SEVEN
+	{0x1C48, charDigit | 8},     // LEPCHA DIGIT EIGHT
+	{0x1C49, charDigit | 9},     // LEPCHA DIGIT NINE
+	{0x1C50, charDigit | 0},     // OL CHIKI DIGIT ZERO
+	{0x1C51, charDigit | 1},     // OL CHIKI DIGIT ONE
+	{0x1C52, charDigit | 2},     // OL CHIKI DIGIT TWO
+	{0x1C53, charDigit | 3},     // OL CHIKI DIGIT THREE
+	{0x1C54, charDigit | 4},     // OL CHIKI DIGIT FOUR
+	{0x1C55, charDigit | 5},     // OL CHIKI DIGIT FIVE
+	{0x1C56, charDigit | 6},     // OL CHIKI DIGIT SIX
+	{0x1C57, charDigit | 7},     // OL CHIKI DIGIT SEVEN
+	{0x1C58, charDigit | 8},     // OL CHIKI DIGIT EIGHT
+	{0x1C59, charDigit | 9},     // OL CHIKI DIGIT NINE
+	{0x1DC2, charStrip},         // COMBINING SNAKE BELOW
+	{0x1DC4, charStrip},         // COMBINING MACRON-ACUTE
+	{0x1DC5, charStrip},         // COMBINING GRAVE-MACRON
+	{0x200E, charStrip},         // LEFT-TO-RIGHT MARK
+	{0x200F, charStrip},         // RIGHT-TO-LEFT MARK
+	{0x2013, charReplace | '_'}, // EN DASH
+	{0x2014, charReplace | '_'}, // EM DASH
+	{0x2070, charDigit | 0},     // SUPERSCRIPT ZERO
+	{0x2074, charDigit | 4},     // SUPERSCRIPT FOUR
+	{0x2075, charDigit | 5},     // SUPERSCRIPT FIVE
+	{0x2076, charDigit | 6},     // SUPERSCRIPT SIX
+	{0x2077, charDigit | 7},     // SUPERSCRIPT SEVEN
+	{0x2078, charDigit | 8},     // SUPERSCRIPT EIGHT
+	{0x2079, charDigit | 9},     // SUPERSCRIPT NINE
+	{0x2080, charDigit | 0},     // SUBSCRIPT ZERO
+	{0x2081, charDigit | 1},     // SUBSCRIPT ONE
+	{0x2082, charDigit | 2},     // SUBSCRIPT TWO
+	{0x2083, charDigit | 3},     // SUBSCRIPT THREE
+	{0x2084, charDigit | 4},     // SUBSCRIPT FOUR
+	{0x2085, charDigit | 5},     // SUBSCRIPT FIVE
+	{0x2086, charDigit | 6},     // SUBSCRIPT SIX
+	{0x2087, charDigit | 7},     // SUBSCRIPT SEVEN
+	{0x2088, charDigit | 8},     // SUBSCRIPT EIGHT
+	{0x2089, charDigit | 9},     // SUBSCRIPT NINE
+	{0x20D9, charStrip},         // COMBINING CLOCKWISE RING OVERLAY
+	{0x20DA, charStrip},         // COMBINING ANTICLOCKWISE RING OVERLAY
+	{0x20DF, charStrip},         // COMBINING ENCLOSING DIAMOND
+	{0x2500, charReplace | '_'}, // BOX DRAWINGS LIGHT HORIZONTAL
+	{0x2501, charReplace | '_'}, // BOX DRAWINGS HEAVY HORIZONTAL
+	{0x2504, charReplace | '…'}, // BOX DRAWINGS LIGHT TRIPLE DASH HORIZONTAL
+	{0x2505, charReplace | '…'}, // BOX DRAWINGS HEAVY TRIPLE DASH HORIZONTAL
+	{0x2508, charReplace | '…'}, // BOX DRAWINGS LIGHT QUADRUPLE DASH HORIZONTAL
+	{0x2796, charReplace | '_'}, // HEAVY MINUS SIGN
+	{0xA620, charDigit | 0},     // VAI DIGIT ZERO
+	{0xA621, charDigit | 1},     // VAI DIGIT ONE
+	{0xA622, charDigit | 2},     // VAI DIGIT TWO
+	{0xA623, charDigit | 3},     // VAI DIGIT THREE
+	{0xA624, charDigit | 4},     // VAI DIGIT FOUR
+	{0xA625, charDigit | 5},     // VAI DIGIT FIVE
+	{0xA626, charDigit | 6},     // VAI DIGIT SIX
+	{0xA627, charDigit | 7},     // VAI DIGIT SEVEN
+	{0xA628, charDigit | 8},     // VAI DIGIT EIGHT
+	{0xA629, charDigit | 9},     // VAI DIGIT NINE
+	{0xA8D0, charDigit | 0},     // SAURASHTRA DIGIT ZERO
+	{0xA8D1, charDigit | 1},     // SAURASHTRA DIGIT ONE
+	{0xA8D2, charDigit | 2},     // SAURASHTRA DIGIT TWO
+	{0xA8D3, charDigit | 3},     // SAURASHTRA DIGIT THREE
+	{0xA8D4, charDigit | 4},     // SAURASHTRA DIGIT FOUR
+	{0xA8D5, charDigit | 5},     // SAURASHTRA DIGIT FIVE
+	{0xA8D6, charDigit | 6},     // SAURASHTRA DIGIT SIX
+	{0xA8D7, charDigit | 7},     // SAURASHTRA DIGIT SEVEN
+	{0xA8D8, charDigit | 8},     // SAURASHTRA DIGIT EIGHT
+	{0xA8D9, charDigit | 9},     // SAURASHTRA DIGIT NINE
+	{0xA900, charDigit | 0},     // KAYAH LI DIGIT ZERO
+	{0xA901, charDigit | 1},     // KAYAH LI DIGIT ONE
+	{0xA902, charDigit | 2},     // KAYAH LI DIGIT TWO
+	{0xA903, charDigit | 3},     // KAYAH LI DIGIT THREE
+	{0xA904, charDigit | 4},     // KAYAH LI DIGIT FOUR
+	{0xA905, charDigit | 5},     // KAYAH LI DIGIT FIVE
+	{0xA906, charDigit | 6},     // KAYAH LI DIGIT SIX
+	{0xA907, charDigit | 7},     // KAYAH LI DIGIT SEVEN
+	{0xA908, charDigit | 8},     // KAYAH LI DIGIT EIGHT
+	{0xA909, charDigit | 9},     // KAYAH LI DIGIT NINE
+	{0xA9D0, charDigit | 0},     // JAVANESE DIGIT ZERO
+	{0xA9D1, charDigit | 1},     // JAVANESE DIGIT ONE
+	{0xA9D2, charDigit | 2},     // JAVANESE DIGIT TWO
+	{0xA9D3, charDigit | 3},     // JAVANESE DIGIT THREE
+	{0xA9D4, charDigit | 4},     // JAVANESE DIGIT FOUR
+	{0xA9D5, charDigit | 5},     // JAVANESE DIGIT FIVE
+	{0xA9D6, charDigit | 6},     // JAVANESE DIGIT SIX
+	{0xA9D7, charDigit | 7},     // JAVANESE DIGIT SEVEN
+	{0xA9D8, charDigit | 8},     // JAVANESE DIGIT EIGHT
+	{0xA9D9, charDigit | 9},     // JAVANESE DIGIT NINE
+	{0xA9F0, charDigit | 0},     // MYANMAR TAI LAING DIGIT ZERO
+	{0xA9F1, charDigit | 1},     // MYANMAR TAI LAING DIGIT ONE
+	{0xA9F2, charDigit | 2},     // MYANMAR TAI LAING DIGIT TWO
+	{0xA9F3, charDigit | 3},     // MYANMAR TAI LAING DIGIT THREE
+	{0xA9F4, charDigit | 4},     // MYANMAR TAI LAING DIGIT FOUR
+	{0xA9F5, charDigit | 5},     // MYANMAR TAI LAING DIGIT FIVE
+	{0xA9F6, charDigit | 6},     // MYANMAR TAI LAING DIGIT SIX
+	{0xA9F7, charDigit | 7},     // MYANMAR TAI LAING DIGIT SEVEN
+	{0xA9F8, charDigit | 8},     // MYANMAR TAI LAING DIGIT EIGHT
+	{0xA9F9, charDigit | 9},     // MYANMAR TAI LAING DIGIT NINE
+	{0xAA50, charDigit | 0},     // CHAM DIGIT ZERO
+	{0xAA51, charDigit | 1},     // CHAM DIGIT ONE
+	{0xAA52, charDigit | 2},     // CHAM DIGIT TWO
+	{0xAA53, charDigit | 3},     // CHAM DIGIT THREE
+	{0xAA54, charDigit | 4},     // CHAM DIGIT FOUR
+	{0xAA55, charDigit | 5},     // CHAM DIGIT FIVE
+	{0xAA56, charDigit | 6},     // CHAM DIGIT SIX
+	{0xAA57, charDigit | 7},     // CHAM DIGIT SEVEN
+	{0xAA58, charDigit | 8},     // CHAM DIGIT EIGHT
+	{0xAA59, charDigit | 9},     // CHAM DIGIT NINE
+	{0xABF0, charDigit | 0},     // MEETEI MAYEK DIGIT ZERO
+	{0xABF1, charDigit | 1},     // MEETEI MAYEK DIGIT ONE
+	{0xABF2, charDigit | 2},     // MEETEI MAYEK DIGIT TWO
+	{0xABF3, charDigit | 3},     // MEETEI MAYEK DIGIT THREE
+	{0xABF4, charDigit | 4},     // MEETEI MAYEK DIGIT FOUR
+	{0xABF5, charDigit | 5},     // MEETEI MAYEK DIGIT FIVE
+	{0xABF6, charDigit | 6},     // MEETEI MAYEK DIGIT SIX
+	{0xABF7, charDigit | 7},     // MEETEI MAYEK DIGIT SEVEN
+	{0xABF8, charDigit | 8},     // MEETEI MAYEK DIGIT EIGHT
+	{0xABF9, charDigit | 9},     // MEETEI MAYEK DIGIT NINE
+	{0xFB38, charReplace | 'ن'}, // HEBREW LETTER TET WITH DAGESH
+	{0xFB50, charReplace | 'ا'}, // ARABIC LETTER ALEF WASLA ISOLATED FORM
+	{0xFB51, charReplace | 'ا'}, // ARABIC LETTER ALEF WASLA FINAL FORM
+	{0xFB52, charReplace | 'ٻ'}, // ARABIC LETTER BEEH ISOLATED FORM
+	{0xFB53, charReplace | 'ٻ'}, // ARABIC LETTER BEEH FINAL FORM
+	{0xFB54, charReplace | 'ٻ'}, // ARABIC LETTER BEEH INITIAL FORM
+	{0xFB55, charReplace | 'ٻ'}, // ARABIC LETTER BEEH MEDIAL FORM
+	{0xFB56, charReplace | 'پ'}, // ARABIC LETTER PEH ISOLATED FORM
+	{0xFB57, charReplace | 'پ'}, // ARABIC LETTER PEH FINAL FORM
+	{0xFB58, charReplace | 'پ'}, // ARABIC LETTER PEH INITIAL FORM
+	{0xFB59, charReplace | 'پ'}, // ARABIC LETTER PEH MEDIAL FORM
+	{0xFB5A, charReplace | 'ڀ'}, // ARABIC LETTER BEHEH ISOLATED FORM
+	{0xFB5B, charReplace | 'ڀ'}, // ARABIC LETTER BEHEH FINAL FORM
+	{0xFB5C, charReplace | 'ڀ'}, // ARABIC LETTER BEHEH INITIAL FORM
+	{0xFB5D, charReplace | 'ڀ'}, // ARABIC LETTER BEHEH MEDIAL FORM
+	{0xFB5E, charReplace | 'ت'}, // ARABIC LETTER TTEHEH ISOLATED FORM
+	{0xFB5F, charReplace | 'ت'}, // ARABIC LETTER TTEHEH FINAL FORM
+	{0xFB60, charReplace | 'ت'}, // ARABIC LETTER TTEHEH INITIAL FORM
+	{0xFB61, charReplace | 'ت'}, // ARABIC LETTER TTEHEH MEDIAL FORM
+	{0xFB62, charReplace | 'ت'}, // ARABIC LETTER TEHEH ISOLATED FORM
+	{0xFB63, charReplace | 'ت'}, // ARABIC LETTER TEHEH FINAL FORM
+	{0xFB64, charReplace | 'ت'}, // ARABIC LETTER TEHEH INITIAL FORM
+	{0xFB65, charReplace | 'ت'}, // ARABIC LETTER TEHEH MEDIAL FORM
+	{0xFB66, charReplace | 'ت'}, // ARABIC LETTER TTEH ISOLATED FORM
+	{0xFB67, charReplace | 'ت'}, // ARABIC LETTER TTEH FINAL FORM
+	{0xFB68, charReplace | 'ت'}, // ARABIC LETTER TTEH INITIAL FORM
+	{0xFB69, charReplace | 'ت'}, // ARABIC LETTER TTEH MEDIAL FORM
+	{0xFB6A, charReplace | 'ف'}, // ARABIC LETTER VEH ISOLATED FORM
+	{0xFB6B, charReplace | 'ف'}, // ARABIC LETTER VEH FINAL FORM
+	{0xFB6C, charReplace | 'ف'}, // ARABIC LETTER VEH INITIAL FORM
+	{0xFB6D, charReplace | 'ف'}, // ARABIC LETTER VEH MEDIAL FORM
+	{0xFB6E, charReplace | 'ق'}, // ARABIC LETTER PEHEH ISOLATED FORM
+	{0xFB6F, charReplace | 'ق'}, // ARABIC LETTER PEHEH FINAL FORM
+	{0xFB70, charReplace | 'ق'}, // ARABIC LETTER PEHEH INITIAL FORM
+	{0xFB71, charReplace | 'ق'}, // ARABIC LETTER PEHEH MEDIAL FORM
+	{0xFB72, charReplace | 'ڄ'}, // ARABIC LETTER DYEH ISOLATED FORM
+	{0xFB73, charReplace | 'ڄ'}, // ARABIC LETTER DYEH FINAL FORM
+	{0xFB74, charReplace | 'ڄ'}, // ARABIC LETTER DYEH INITIAL FORM
+	{0xFB75, charReplace | 'ڄ'}, // ARABIC LETTER DYEH MEDIAL FORM
+	{0xFB76, charReplace | 'ج'}, // ARABIC LETTER NYEH ISOLATED FORM
+	{0xFB77, charReplace | 'ج'}, // ARABIC LETTER NYEH FINAL FORM
+	{0xFB78, charReplace | 'ج'}, // ARABIC LETTER NYEH INITIAL FORM
+	{0xFB79, charReplace | 'ج'}, // ARABIC LETTER NYEH MEDIAL FORM
+	{0xFB7A, charReplace | 'چ'}, // ARABIC LETTER TCHEH ISOLATED FORM
+	{0xFB7B, charReplace | 'چ'}, // ARABIC LETTER TCHEH FINAL FORM
+	{0xFB7C, charReplace | 'چ'}, // ARABIC LETTER TCHEH INITIAL FORM
+	{0xFB7D, charReplace | 'چ'}, // ARABIC LETTER TCHEH MEDIAL FORM
+	{0xFB7E, charReplace | 'چ'}, // ARABIC LETTER TCHEHEH ISOLATED FORM
+	{0xFB7F, charReplace | 'چ'}, // ARABIC LETTER TCHEHEH FINAL FORM
+	{0xFB80, charReplace | 'چ'}, // ARABIC LETTER TCHEHEH INITIAL FORM
+	{0xFB81, charReplace | 'چ'}, // ARABIC LETTER TCHEHEH MEDIAL FORM
+	{0xFB82, charReplace | 'د'}, // ARABIC LETTER DDAHAL ISOLATED FORM
+	{0xFB83, charReplace | 'د'}, // ARABIC LETTER DDAHAL FINAL FORM
+	{0xFB84, charReplace | 'د'}, // ARABIC LETTER DAHAL ISOLATED FORM
+	{0xFB85, charReplace | 'د'}, // ARABIC LETTER DAHAL FINAL FORM
+	{0xFB86, charReplace | 'ڎ'}, // ARABIC LETTER DUL ISOLATED FORM
+	{0xFB87, charReplace | 'ڎ'}, // ARABIC LETTER DUL FINAL FORM
+	{0xFB88, charReplace | 'د'}, // ARABIC LETTER DDAL ISOLATED FORM
+	{0xFB89, charReplace | 'د'}, // ARABIC LETTER DDAL FINAL FORM
+	{0xFB8A, charReplace | 'ژ'}, // ARABIC LETTER JEH ISOLATED FORM
+	{0xFB8B, charReplace | 'ژ'}, // ARABIC LETTER JEH FINAL FORM
+	{0xFB8C, charReplace | 'ر'}, // ARABIC LETTER RREH ISOLATED FORM
+	{0xFB8D, charReplace | 'ر'}, // ARABIC LETTER RREH FINAL FORM
+	{0xFB8E, charReplace | 'ک'}, // ARABIC LETTER KEHEH ISOLATED FORM
+	{0xFB8F, charReplace | 'ک'}, // ARABIC LETTER KEHEH FINAL FORM
+	{0xFB90, charReplace | 'ک'}, // ARABIC LETTER KEHEH INITIAL FORM
+	{0xFB91, charReplace | 'ک'}, // ARABIC LETTER KEHEH MEDIAL FORM
+	{0xFB92, charReplace | 'گ'}, // ARABIC LETTER GAF ISOLATED FORM
+	{0xFB93, charReplace | 'گ'}, // ARABIC LETTER GAF FINAL FORM
+	{0xFB94, charReplace | 'گ'}, // ARABIC LETTER GAF INITIAL FORM
+	{0xFB95, charReplace | 'گ'}, // ARABIC LETTER GAF MEDIAL FORM
+	{0xFB96, charReplace | 'گ'}, // ARABIC LETTER GUEH ISOLATED FORM
+	{0xFB97, charReplace | 'گ'}, // ARABIC LETTER GUEH FINAL FORM
+	{0xFB98, charReplace | 'گ'}, // ARABIC LETTER GUEH INITIAL FORM
+	{0xFB99, charReplace | 'گ'}, // ARABIC LETTER GUEH MEDIAL FORM
+	{0xFB9A, charReplace | 'گ'}, // ARABIC LETTER NGOEH ISOLATED FORM
+	{0xFB9B, charReplace | 'گ'}, // ARABIC LETTER NGOEH FINAL FORM
+	{0xFB9C, charReplace | 'گ'}, // ARABIC LETTER NGOEH INITIAL FORM
+	{0xFB9D, charReplace | 'گ'}, // ARABIC LETTER NGOEH MEDIAL FORM
+	{0xFB9E, charReplace | 'ن'}, // ARABIC LETTER NOON GHUNNA ISOLATED FORM
+	{0xFB9F, charReplace | 'ن'}, // ARABIC LETTER NOON GHUNNA FINAL FORM
+	{0xFBA0, charReplace | 'ن'}, // ARABIC LETTER RNOON ISOLATED FORM
+	{0xFBA1, charReplace | 'ن'}, // ARABIC LETTER RNOON FINAL FORM
+	{0xFBA2, charReplace | 'ن'}, // ARABIC LETTER RNOON INITIAL FORM
+	{0xFBA3, charReplace | 'ن'}, // ARABIC LETTER RNOON MEDIAL FORM
+	{0xFBA4, charReplace | 'ه'}, // ARABIC LETTER HEH WITH YEH ABOVE ISOLATED FORM
+	{0xFBA5, charReplace | 'ه'}, // ARABIC LETTER HEH WITH YEH ABOVE FINAL FORM
+	{0xFBA6, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL ISOLATED FORM
+	{0xFBA7, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL FINAL FORM
+	{0xFBA8, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL INITIAL FORM
+	{0xFBA9, charReplace | 'ه'}, // ARABIC LETTER HEH GOAL MEDIAL FORM
+	{0xFBAA, charReplace | 'ه'}, // ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM
+	{0xFBAB, charReplace | 'ه'}, // ARABIC LETTER HEH DOACHASHMEE FINAL FORM
+	{0xFBAC, charReplace | 'ه'}, // ARABIC LETTER HEH DOACHASHMEE INITIAL FORM
+	{0xFBAD, charReplace | 'ه'}, // ARABIC LETTER HEH DOACHASHMEE MEDIAL FORM
+	{0xFBAE, charReplace | 'ی'}, // ARABIC LETTER YEH BARREE ISOLATED FORM
+	{0xFBAF, charReplace | 'ی'}, // ARABIC LETTER YEH BARREE FINAL FORM
+	{0xFBB0, charReplace | 'ۓ'}, // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM
+	{0xFBB1, charReplace | 'ۓ'}, // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
+	{0xFBD3, charReplace | 'ک'}, // ARABIC LETTER NG ISOLATED FORM
+	{0xFBD4, charReplace | 'ک'}, // ARABIC LETTER NG FINAL FORM
+	{0xFBD5, charReplace | 'ک'}, // ARABIC LETTER NG INITIAL FORM
+	{0xFBD6, charReplace | 'ک'}, // ARABIC LETTER NG MEDIAL FORM
+	{0xFBD7, charReplace | 'و'}, // ARABIC LETTER U ISOLATED FORM
+	{0xFBD8, charReplace | 'و'}, // ARABIC LETTER U FINAL FORM
+	{0xFBD9, charReplace | 'و'}, // ARABIC LETTER OE ISOLATED FORM
+	{0xFBDA, charReplace | 'و'}, // ARABIC LETTER OE FINAL FORM
+	{0xFBDB, charReplace | 'و'}, // ARABIC LETTER YU ISOLATED FORM
+	{0xFBDC, charReplace | 'و'}, // ARABIC LETTER YU FINAL FORM
+	{0xFBDD, charReplace | 'و'}, // ARABIC LETTER U WITH HAMZA ABOVE ISOLATED FORM
+	{0xFBDE, charReplace | 'و'}, // ARABIC LETTER VE ISOLATED FORM
+	{0xFBDF, charReplace | 'و'}, // ARABIC LETTER VE FINAL FORM
+	{0xFBE0, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ OE ISOLATED FORM
+	{0xFBE1, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ OE FINAL FORM
+	{0xFBE2, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ YU ISOLATED FORM
+	{0xFBE3, charReplace | 'و'}, // ARABIC LETTER KIRGHIZ YU FINAL FORM
+	{0xFBE4, charReplace | 'ی'}, // ARABIC LETTER E ISOLATED FORM
+	{0xFBE5, charReplace | 'ی'}, // ARABIC LETTER E FINAL FORM
+	{0xFBE6, charReplace | 'ی'}, // ARABIC LETTER E INITIAL FORM
+	{0xFBE7, charReplace | 'ی'}, // ARABIC LETTER E MEDIAL FORM
+	{0xFBE8, charReplace | 'ی'}, // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM
+	{0xFBE9, charReplace | 'ی'}, // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA MEDIAL FORM
+	{0xFBFC, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH ISOLATED FORM
+	{0xFBFD, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH FINAL FORM
+	{0xFBFE, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH INITIAL FORM
+	{0xFBFF, charReplace | 'ی'}, // ARABIC LETTER FARSI YEH MEDIAL FORM
+	{0xFC5B, charReplace | 'ذ'}, // ARABIC LIGATURE THAL WITH SUPERSCRIPT ALEF ISOLATED FORM
+	{0xFC5C, charReplace | 'ر'}, // ARABIC LIGATURE REH WITH SUPERSCRIPT ALEF ISOLATED FORM
+	{0xFC5D, charReplace | 'ی'}, // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	{0xFC5E, charStrip},         // ARABIC LIGATURE SHADDA WITH DAMMATAN ISOLATED FORM
+	{0xFC5F, charStrip},         // ARABIC LIGATURE SHADDA WITH KASRATAN ISOLATED FORM
+	{0xFC60, charStrip},         // ARABIC LIGATURE SHADDA WITH FATHA ISOLATED FORM
+	{0xFC61, charStrip},         // ARABIC LIGATURE SHADDA WITH DAMMA ISOLATED FORM
+	{0xFC62, charStrip},         // ARABIC LIGATURE SHADDA WITH KASRA ISOLATED FORM
+	{0xFC63, charStrip},         // ARABIC LIGATURE SHADDA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	{0xFC90, charReplace | 'ی'}, // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM
+	{0xFCD9, charReplace | 'ه'}, // ARABIC LIGATURE HEH WITH SUPERSCRIPT ALEF INITIAL FORM
+	{0xFCF2, charStrip},         // ARABIC LIGATURE SHADDA WITH FATHA MEDIAL FORM
+	{0xFCF3, charStrip},         // ARABIC LIGATURE SHADDA WITH DAMMA MEDIAL FORM
+	{0xFCF4, charStrip},         // ARABIC LIGATURE SHADDA WITH KASRA MEDIAL FORM
+	{0xFD3C, charReplace | 'ا'}, // ARABIC LIGATURE ALEF WITH FATHATAN FINAL FORM
+	{0xFD3D, charReplace | 'ا'}, // ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
+	{0xFE70, charStrip},         // ARABIC FATHATAN ISOLATED FORM
+	{0xFE71, charStrip},         // ARABIC TATWEEL WITH FATHATAN ABOVE
+	{0xFE72, charStrip},         // ARABIC DAMMATAN ISOLATED FORM
+	{0xFE74, charStrip},         // ARABIC KASRATAN ISOLATED FORM
+	{0xFE76, charStrip},         // ARABIC FATHA ISOLATED FORM
+	{0xFE77, charStrip},         // ARABIC FATHA MEDIAL FORM
+	{0xFE78, charStrip},         // ARABIC DAMMA ISOLATED FORM
+	{0xFE79, charStrip},         // ARABIC DAMMA MEDIAL FORM
+	{0xFE7A, charStrip},         // ARABIC KASRA ISOLATED FORM
+	{0xFE7B, charStrip},         // ARABIC KASRA MEDIAL FORM
+	{0xFE7C, charStrip},         // ARABIC SHADDA ISOLATED FORM
+	{0xFE7D, charStrip},         // ARABIC SHADDA MEDIAL FORM
+	{0xFE7E, charStrip},         // ARABIC SUKUN ISOLATED FORM
+	{0xFE7F, charStrip},         // ARABIC SUKUN MEDIAL FORM
+	{0xFE80, charStrip},         // ARABIC LETTER HAMZA ISOLATED FORM
+	{0xFE81, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM
+	{0xFE82, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM
+	{0xFE83, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM
+	{0xFE84, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM
+	{0xFE85, charReplace | 'و'}, // ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM
+	{0xFE86, charReplace | 'و'}, // ARABIC LETTER WAW WITH HAMZA ABOVE FINAL FORM
+	{0xFE87, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA BELOW ISOLATED FORM
+	{0xFE88, charReplace | 'ا'}, // ARABIC LETTER ALEF WITH HAMZA BELOW FINAL FORM
+	{0xFE89, charReplace | 'ی'}, // ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM
+	{0xFE8A, charReplace | 'ی'}, // ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM
+	{0xFE8B, charReplace | 'ی'}, // ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM
+	{0xFE8C, charReplace | 'ی'}, // ARABIC LETTER YEH WITH HAMZA ABOVE MEDIAL FORM
+	{0xFE8D, charReplace | 'ا'}, // ARABIC LETTER ALEF ISOLATED FORM
+	{0xFE8E, charReplace | 'ا'}, // ARABIC LETTER ALEF FINAL FORM
+	{0xFE8F, charReplace | 'ب'}, // ARABIC LETTER BEH ISOLATED FORM
+	{0xFE90, charReplace | 'ب'}, // ARABIC LETTER BEH FINAL FORM
+	{0xFE91, charReplace | 'ب'}, // ARABIC LETTER BEH INITIAL FORM
+	{0xFE92, charReplace | 'ب'}, // ARABIC LETTER BEH MEDIAL FORM
+	{0xFE93, charReplace | 'ه'}, // ARABIC LETTER TEH MARBUTA ISOLATED FORM
+	{0xFE94, charReplace | 'ه'}, // ARABIC LETTER TEH MARBUTA FINAL FORM
+	{0xFE95, charReplace | 'ت'}, // ARABIC LETTER TEH ISOLATED FORM
+	{0xFE96, charReplace | 'ت'}, // ARABIC LETTER TEH FINAL FORM
+	{0xFE97, charReplace | 'ت'}, // ARABIC LETTER TEH INITIAL FORM
+	{0xFE98, charReplace | 'ت'}, // ARABIC LETTER TEH MEDIAL FORM
+	{0xFE99, charReplace | 'ث'}, // ARABIC LETTER THEH ISOLATED FORM
+	{0xFE9A, charReplace | 'ث'}, // ARABIC LETTER THEH FINAL FORM
+	{0xFE9B, charReplace | 'ث'}, // ARABIC LETTER THEH INITIAL FORM
+	{0xFE9C, charReplace | 'ث'}, // ARABIC LETTER THEH MEDIAL FORM
+	{0xFE9D, charReplace | 'ج'}, // ARABIC LETTER JEEM ISOLATED FORM
+	{0xFE9E, charReplace | 'ج'}, // ARABIC LETTER JEEM FINAL FORM
+	{0xFE9F, charReplace | 'ج'}, // ARABIC LETTER JEEM INITIAL FORM
+	{0xFEA0, charReplace | 'ج'}, // ARABIC LETTER JEEM MEDIAL FORM
+	{0xFEA1, charReplace | 'ح'}, // ARABIC LETTER HAH ISOLATED FORM
+	{0xFEA2, charReplace | 'ح'}, // ARABIC LETTER HAH FINAL FORM
+	{0xFEA3, charReplace | 'ح'}, // ARABIC LETTER HAH INITIAL FORM
+	{0xFEA4, charReplace | 'ح'}, // ARABIC LETTER HAH MEDIAL FORM
+	{0xFEA5, charReplace | 'خ'}, // ARABIC LETTER KHAH ISOLATED FORM
+	{0xFEA6, charReplace | 'خ'}, // ARABIC LETTER KHAH FINAL FORM
+	{0xFEA7, charReplace | 'خ'}, // ARABIC LETTER KHAH INITIAL FORM
+	{0xFEA8, charReplace | 'خ'}, // ARABIC LETTER KHAH MEDIAL FORM
+	{0xFEA9, charReplace | 'د'}, // ARABIC LETTER DAL ISOLATED FORM
+	{0xFEAA, charReplace | 'د'}, // ARABIC LETTER DAL FINAL FORM
+	{0xFEAB, charReplace | 'ذ'}, // ARABIC LETTER THAL ISOLATED FORM
+	{0xFEAC, charReplace | 'ذ'}, // ARABIC LETTER THAL FINAL FORM
+	{0xFEAD, charReplace | 'ر'}, // ARABIC LETTER REH ISOLATED FORM
+	{0xFEAE, charReplace | 'ر'}, // ARABIC LETTER REH FINAL FORM
+	{0xFEAF, charReplace | 'ز'}, // ARABIC LETTER ZAIN ISOLATED FORM
+	{0xFEB0, charReplace | 'ز'}, // ARABIC LETTER ZAIN FINAL FORM
+	{0xFEB1, charReplace | 'س'}, // ARABIC LETTER SEEN ISOLATED FORM
+	{0xFEB2, charReplace | 'س'}, // ARABIC LETTER SEEN FINAL FORM
+	{0xFEB3, charReplace | 'س'}, // ARABIC LETTER SEEN INITIAL FORM
+	{0xFEB4, charReplace | 'س'}, // ARABIC LETTER SEEN MEDIAL FORM
+	{0xFEB5, charReplace | 'ش'}, // ARABIC LETTER SHEEN ISOLATED FORM
+	{0xFEB6, charReplace | 'ش'}, // ARABIC LETTER SHEEN FINAL FORM
+	{0xFEB7, charReplace | 'ش'}, // ARABIC LETTER SHEEN INITIAL FORM
+	{0xFEB8, charReplace | 'ش'}, // ARABIC LETTER SHEEN MEDIAL FORM
+	{0xFEB9, charReplace | 'ص'}, // ARABIC LETTER SAD ISOLATED FORM
+	{0xFEBA, charReplace | 'ص'}, // ARABIC LETTER SAD FINAL FORM
+	{0xFEBB, charReplace | 'ص'}, // ARABIC LETTER SAD INITIAL FORM
+	{0xFEBC, charReplace | 'ص'}, // ARABIC LETTER SAD MEDIAL FORM
+	{0xFEBD, charReplace | 'ض'}, // ARABIC LETTER DAD ISOLATED FORM
+	{0xFEBE, charReplace | 'ض'}, // ARABIC LETTER DAD FINAL FORM
+	{0xFEBF, charReplace | 'ض'}, // ARABIC LETTER DAD INITIAL FORM
+	{0xFEC0, charReplace | 'ض'}, // ARABIC LETTER DAD MEDIAL FORM
+	{0xFEC1, charReplace | 'ط'}, // ARABIC LETTER TAH ISOLATED FORM
+	{0xFEC2, charReplace | 'ط'}, // ARABIC LETTER TAH FINAL FORM
+	{0xFEC3, charReplace | 'ط'}, // ARABIC LETTER TAH INITIAL FORM
+	{0xFEC4, charReplace | 'ط'}, // ARABIC LETTER TAH MEDIAL FORM
+	{0xFEC5, charReplace | 'ظ'}, // ARABIC LETTER ZAH ISOLATED FORM
+	{0xFEC6, charReplace | 'ظ'}, // ARABIC LETTER ZAH FINAL FORM
+	{0xFEC7, charReplace | 'ظ'}, // ARABIC LETTER ZAH INITIAL FORM
+	{0xFEC8, charReplace | 'ظ'}, // ARABIC LETTER ZAH MEDIAL FORM
+	{0xFEC9, charReplace | 'ع'}, // ARABIC LETTER AIN ISOLATED FORM
+	{0xFECA, charReplace | 'ع'}, // ARABIC LETTER AIN FINAL FORM
+	{0xFECB, charReplace | 'ع'}, // ARABIC LETTER AIN INITIAL FORM
+	{0xFECC, charReplace | 'ع'}, // ARABIC LETTER AIN MEDIAL FORM
+	{0xFECD, charReplace | 'غ'}, // ARABIC LETTER GHAIN ISOLATED FORM
+	{0xFECE, charReplace | 'غ'}, // ARABIC LETTER GHAIN FINAL FORM
+	{0xFECF, charReplace | 'غ'}, // ARABIC LETTER GHAIN INITIAL FORM
+	{0xFED0, charReplace | 'غ'}, // ARABIC LETTER GHAIN MEDIAL FORM
+	{0xFED1, charReplace | 'ف'}, // ARABIC LETTER FEH ISOLATED FORM
+	{0xFED2, charReplace | 'ف'}, // ARABIC LETTER FEH FINAL FORM
+	{0xFED3, charReplace | 'ف'}, // ARABIC LETTER FEH INITIAL FORM
+	{0xFED4, charReplace | 'ف'}, // ARABIC LETTER FEH MEDIAL FORM
+	{0xFED5, charReplace | 'ق'}, // ARABIC LETTER QAF ISOLATED FORM
+	{0xFED6, charReplace | 'ق'}, // ARABIC LETTER QAF FINAL FORM
+	{0xFED7, charReplace | 'ق'}, // ARABIC LETTER QAF INITIAL FORM
+	{0xFED8, charReplace | 'ق'}, // ARABIC LETTER QAF MEDIAL FORM
+	{0xFED9, charReplace | 'ک'}, // ARABIC LETTER KAF ISOLATED FORM
+	{0xFEDA, charReplace | 'ک'}, // ARABIC LETTER KAF FINAL FORM
+	{0xFEDB, charReplace | 'ک'}, // ARABIC LETTER KAF INITIAL FORM
+	{0xFEDC, charReplace | 'ک'}, // ARABIC LETTER KAF MEDIAL FORM
+	{0xFEDD, charReplace | 'ل'}, // ARABIC LETTER LAM ISOLATED FORM
+	{0xFEDE, charReplace | 'ل'}, // ARABIC LETTER LAM FINAL FORM
+	{0xFEDF, charReplace | 'ل'}, // ARABIC LETTER LAM INITIAL FORM
+	{0xFEE0, charReplace | 'ل'}, // ARABIC LETTER LAM MEDIAL FORM
+	{0xFEE1, charReplace | 'م'}, // ARABIC LETTER MEEM ISOLATED FORM
+	{0xFEE2, charReplace | 'م'}, // ARABIC LETTER MEEM FINAL FORM
+	{0xFEE3, charReplace | 'م'}, // ARABIC LETTER MEEM INITIAL FORM
+	{0xFEE4, charReplace | 'م'}, // ARABIC LETTER MEEM MEDIAL FORM
+	{0xFEE5, charReplace | 'ن'}, // ARABIC LETTER NOON ISOLATED FORM
+	{0xFEE6, charReplace | 'ن'}, // ARABIC LETTER NOON FINAL FORM
+	{0xFEE7, charReplace | 'ن'}, // ARABIC LETTER NOON INITIAL FORM
+	{0xFEE8, charReplace | 'ن'}, // ARABIC LETTER NOON MEDIAL FORM
+	{0xFEE9, charReplace | 'ه'}, // ARABIC LETTER HEH ISOLATED FORM
+	{0xFEEA, charReplace | 'ه'}, // ARABIC LETTER HEH FINAL FORM
+	{0xFEEB, charReplace | 'ه'}, // ARABIC LETTER HEH INITIAL FORM
+	{0xFEEC, charReplace | 'ه'}, // ARABIC LETTER HEH MEDIAL FORM
+	{0xFEED, charReplace | 'و'}, // ARABIC LETTER WAW ISOLATED FORM
+	{0xFEEE, charReplace | 'و'}, // ARABIC LETTER WAW FINAL FORM
+	{0xFEEF, charReplace | 'ی'}, // ARABIC LETTER ALEF MAKSURA ISOLATED FORM
+	{0xFEF0, charReplace | 'ی'}, // ARABIC LETTER ALEF MAKSURA FINAL FORM
+	{0xFEF1, charReplace | 'ی'}, // ARABIC LETTER YEH ISOLATED FORM
+	{0xFEF2, charReplace | 'ی'}, // ARABIC LETTER YEH FINAL FORM
+	{0xFEF3, charReplace | 'ی'}, // ARABIC LETTER YEH INITIAL FORM
+	{0xFEF4, charReplace | 'ی'}, // ARABIC LETTER YEH MEDIAL FORM
+	{0xFF10, charDigit | 0},     // FULLWIDTH DIGIT ZERO
+	{0xFF11, charDigit | 1},     // FULLWIDTH DIGIT ONE
+	{0xFF12, charDigit | 2},     // FULLWIDTH DIGIT TWO
+	{0xFF13, charDigit | 3},     // FULLWIDTH DIGIT THREE
+	{0xFF14, charDigit | 4},     // FULLWIDTH DIGIT FOUR
+	{0xFF15, charDigit | 5},     // FULLWIDTH DIGIT FIVE
+	{0xFF16, charDigit | 6},     // FULLWIDTH DIGIT SIX
+	{0xFF17, charDigit | 7},     // FULLWIDTH DIGIT SEVEN
+	{0xFF18, charDigit | 8},     // FULLWIDTH DIGIT EIGHT
+	{0xFF19, charDigit | 9},     // FULLWIDTH DIGIT NINE
+	{0xFF1A, charReplace | ':'}, // FULLWIDTH COLON
+	{0xFFFF, charStrip},         // <noncharacter>
+	{0x104A0, charDigit | 0},    // OSMANYA DIGIT ZERO
+	{0x104A1, charDigit | 1},    // OSMANYA DIGIT ONE
+	{0x104A2, charDigit | 2},    // OSMANYA DIGIT TWO
+	{0x104A3, charDigit | 3},    // OSMANYA DIGIT THREE
+	{0x104A4, charDigit | 4},    // OSMANYA DIGIT FOUR
+	{0x104A5, charDigit | 5},    // OSMANYA DIGIT FIVE
+	{0x104A6, charDigit | 6},    // OSMANYA DIGIT SIX
+	{0x104A7, charDigit | 7},    // OSMANYA DIGIT SEVEN
+	{0x104A8, charDigit | 8},    // OSMANYA DIGIT EIGHT
+	{0x104A9, charDigit | 9},    // OSMANYA DIGIT NINE
+	{0x10D30, charDigit | 0},    // HANIFI ROHINGYA DIGIT ZERO
+	{0x10D31, charDigit | 1},    // HANIFI ROHINGYA DIGIT ONE
+	{0x10D32, charDigit | 2},    // HANIFI ROHINGYA DIGIT TWO
+	{0x10D33, charDigit | 3},    // HANIFI ROHINGYA DIGIT THREE
+	{0x10D34, charDigit | 4},    // HANIFI ROHINGYA DIGIT FOUR
+	{0x10D35, charDigit | 5},    // HANIFI ROHINGYA DIGIT FIVE
+	{0x10D36, charDigit | 6},    // HANIFI ROHINGYA DIGIT SIX
+	{0x10D37, charDigit | 7},    // HANIFI ROHINGYA DIGIT SEVEN
+	{0x10D38, charDigit | 8},    // HANIFI ROHINGYA DIGIT EIGHT
+	{0x10D39, charDigit | 9},    // HANIFI ROHINGYA DIGIT NINE
+	{0x11066, charDigit | 0},    // BRAHMI DIGIT ZERO
+	{0x11067, charDigit | 1},    // BRAHMI DIGIT ONE
+	{0x11068, charDigit | 2},    // BRAHMI DIGIT TWO
+	{0x11069, charDigit | 3},    // BRAHMI DIGIT THREE
+	{0x1106A, charDigit | 4},    // BRAHMI DIGIT FOUR
+	{0x1106B, charDigit | 5},    // BRAHMI DIGIT FIVE
+	{0x1106C, charDigit | 6},    // BRAHMI DIGIT SIX
+	{0x1106D, charDigit | 7},    // BRAHMI DIGIT SEVEN
+	{0x1106E, charDigit | 8},    // BRAHMI DIGIT EIGHT
+	{0x1106F, charDigit | 9},    // BRAHMI DIGIT NINE
+	{0x110F0, charDigit | 0},    // SORA SOMPENG DIGIT ZERO
+	{0x110F1, charDigit | 1},    // SORA SOMPENG DIGIT ONE
+	{0x110F2, charDigit | 2},    // SORA SOMPENG DIGIT TWO
+	{0x110F3, charDigit | 3},    // SORA SOMPENG DIGIT THREE
+	{0x110F4, charDigit | 4},    // SORA SOMPENG DIGIT FOUR
+	{0x110F5, charDigit | 5},    // SORA SOMPENG DIGIT FIVE
+	{0x110F6, charDigit | 6},    // SORA SOMPENG DIGIT SIX
+	{0x110F7, charDigit | 7},    // SORA SOMPENG DIGIT SEVEN
+	{0x110F8, charDigit | 8},    // SORA SOMPENG DIGIT EIGHT
+	{0x110F9, charDigit | 9},    // SORA SOMPENG DIGIT NINE
+	{0x11136, charDigit | 0},    // CHAKMA DIGIT ZERO
+	{0x11137, charDigit | 1},    // CHAKMA DIGIT ONE
+	{0x11138, charDigit | 2},    // CHAKMA DIGIT TWO
+	{0x11139, charDigit | 3},    // CHAKMA DIGIT THREE
+	{0x1113A, charDigit | 4},    // CHAKMA DIGIT FOUR
+	{0x1113B, charDigit | 5},    // CHAKMA DIGIT FIVE
+	{0x1113C, charDigit | 6},    // CHAKMA DIGIT SIX
+	{0x1113D, charDigit | 7},    // CHAKMA DIGIT SEVEN
+	{0x1113E, charDigit | 8},    // CHAKMA DIGIT EIGHT
+	{0x1113F, charDigit | 9},    // CHAKMA DIGIT NINE
+	{0x111D0, charDigit | 0},    // SHARADA DIGIT ZERO
+	{0x111D1, charDigit | 1},    // SHARADA DIGIT ONE
+	{0x111D2, charDigit | 2},    // SHARADA DIGIT TWO
+	{0x111D3, charDigit | 3},    // SHARADA DIGIT THREE
+	{0x111D4, charDigit | 4},    // SHARADA DIGIT FOUR
+	{0x111D5, charDigit | 5},    // SHARADA DIGIT FIVE
+	{0x111D6, charDigit | 6},    // SHARADA DIGIT SIX
+	{0x111D7, charDigit | 7},    // SHARADA DIGIT SEVEN
+	{0x111D8, charDigit | 8},    // SHARADA DIGIT EIGHT
+	{0x111D9, charDigit | 9},    // SHARADA DIGIT NINE
+	{0x112F0, charDigit | 0},    // KHUDAWADI DIGIT ZERO
+	{0x112F1, charDigit | 1},    // KHUDAWADI DIGIT ONE
+	{0x112F2, charDigit | 2},    // KHUDAWADI DIGIT TWO
+	{0x112F3, charDigit | 3},    // KHUDAWADI DIGIT THREE
+	{0x112F4, charDigit | 4},    // KHUDAWADI DIGIT FOUR
+	{0x112F5, charDigit | 5},    // KHUDAWADI DIGIT FIVE
+	{0x112F6, charDigit | 6},    // KHUDAWADI DIGIT SIX
+	{0x112F7, charDigit | 7},    // KHUDAWADI DIGIT SEVEN
+	{0x112F8, charDigit | 8},    // KHUDAWADI DIGIT EIGHT
+	{0x112F9, charDigit | 9},    // KHUDAWADI DIGIT NINE
+	{0x11450, charDigit | 0},    // NEWA DIGIT ZERO
+	{0x11451, charDigit | 1},    // NEWA DIGIT ONE
+	{0x11452, charDigit | 2},    // NEWA DIGIT TWO
+	{0x11453, charDigit | 3},    // NEWA DIGIT THREE
+	{0x11454, charDigit | 4},    // NEWA DIGIT FOUR
+	{0x11455, charDigit | 5},    // NEWA DIGIT FIVE
+	{0x11456, charDigit | 6},    // NEWA DIGIT SIX
+	{0x11457, charDigit | 7},    // NEWA DIGIT SEVEN
+	{0x11458, charDigit | 8},    // NEWA DIGIT EIGHT
+	{0x11459, charDigit | 9},    // NEWA DIGIT NINE
+	{0x114D0, charDigit | 0},    // TIRHUTA DIGIT ZERO
+	{0x114D1, charDigit | 1},    // TIRHUTA DIGIT ONE
+	{0x114D2, charDigit | 2},    // TIRHUTA DIGIT TWO
+	{0x114D3, charDigit | 3},    // TIRHUTA DIGIT THREE
+	{0x114D4, charDigit | 4},    // TIRHUTA DIGIT FOUR
+	{0x114D5, charDigit | 5},    // TIRHUTA DIGIT FIVE
+	{0x114D6, charDigit | 6},    // TIRHUTA DIGIT SIX
+	{0x114D7, charDigit | 7},    // TIRHUTA DIGIT SEVEN
+	{0x114D8, charDigit | 8},    // TIRHUTA DIGIT EIGHT
+	{0x114D9, charDigit | 9},    // TIRHUTA DIGIT NINE
+	{0x11650, charDigit | 0},    // MODI DIGIT ZERO
+	{0x11651, charDigit | 1},    // MODI DIGIT ONE
+	{0x11652, charDigit | 2},    // MODI DIGIT TWO
+	{0x11653, charDigit | 3},    // MODI DIGIT THREE
+	{0x11654, charDigit | 4},    // MODI DIGIT FOUR
+	{0x11655, charDigit | 5},    // MODI DIGIT FIVE
+	{0x11656, charDigit | 6},    // MODI DIGIT SIX
+	{0x11657, charDigit | 7},    // MODI DIGIT SEVEN
+	{0x11658, charDigit | 8},    // MODI DIGIT EIGHT
+	{0x11659, charDigit | 9},    // MODI DIGIT NINE
+	{0x116C0, charDigit | 0},    // TAKRI DIGIT ZERO
+	{0x116C1, charDigit | 1},    // TAKRI DIGIT ONE
+	{0x116C2, charDigit | 2},    // TAKRI DIGIT TWO
+	{0x116C3, charDigit | 3},    // TAKRI DIGIT THREE
+	{0x116C4, charDigit | 4},    // TAKRI DIGIT FOUR
+	{0x116C5, charDigit | 5},    // TAKRI DIGIT FIVE
+	{0x116C6, charDigit | 6},    // TAKRI DIGIT SIX
+	{0x116C7, charDigit | 7},    // TAKRI DIGIT SEVEN
+	{0x116C8, charDigit | 8},    // TAKRI DIGIT EIGHT
+	{0x116C9, charDigit | 9},    // TAKRI DIGIT NINE
+	{0x11730, charDigit | 0},    // AHOM DIGIT ZERO
+	{0x11731, charDigit | 1},    // AHOM DIGIT ONE
+	{0x11732, charDigit | 2},    // AHOM DIGIT TWO
+	{0x11733, charDigit | 3},    // AHOM DIGIT THREE
+	{0x11734, charDigit | 4},    // AHOM DIGIT FOUR
+	{0x11735, charDigit | 5},    // AHOM DIGIT FIVE
+	{0x11736, charDigit | 6},    // AHOM DIGIT SIX
+	{0x11737, charDigit | 7},    // AHOM DIGIT SEVEN
+	{0x11738, charDigit | 8},    // AHOM DIGIT EIGHT
+	{0x11739, charDigit | 9},    // AHOM DIGIT NINE
+	{0x118E0, charDigit | 0},    // WARANG CITI DIGIT ZERO
+	{0x118E1, charDigit | 1},    // WARANG CITI DIGIT ONE
+	{0x118E2, charDigit | 2},    // WARANG CITI DIGIT TWO
+	{0x118E3, charDigit | 3},    // WARANG CITI DIGIT THREE
+	{0x118E4, charDigit | 4},    // WARANG CITI DIGIT FOUR
+	{0x118E5, charDigit | 5},    // WARANG CITI DIGIT FIVE
+	{0x118E6, charDigit | 6},    // WARANG CITI DIGIT SIX
+	{0x118E7, charDigit | 7},    // WARANG CITI DIGIT SEVEN
+	{0x118E8, charDigit | 8},    // WARANG CITI DIGIT EIGHT
+	{0x118E9, charDigit | 9},    // WARANG CITI DIGIT NINE
+	{0x11950, charDigit | 0},    // DIVES AKURU DIGIT ZERO
+	{0x11951, charDigit | 1},    // DIVES AKURU DIGIT ONE
+	{0x11952, charDigit | 2},    // DIVES AKURU DIGIT TWO
+	{0x11953, charDigit | 3},    // DIVES AKURU DIGIT THREE
+	{0x11954, charDigit | 4},    // DIVES AKURU DIGIT FOUR
+	{0x11955, charDigit | 5},    // DIVES AKURU DIGIT FIVE
+	{0x11956, charDigit | 6},    // DIVES AKURU DIGIT SIX
+	{0x11957, charDigit | 7},    // DIVES AKURU DIGIT SEVEN
+	{0x11958, charDigit | 8},    // DIVES AKURU DIGIT EIGHT
+	{0x11959, charDigit | 9},    // DIVES AKURU DIGIT NINE
+	{0x11C50, charDigit | 0},    // BHAIKSUKI DIGIT ZERO
+	{0x11C51, charDigit | 1},    // BHAIKSUKI DIGIT ONE
+	{0x11C52, charDigit | 2},    // BHAIKSUKI DIGIT TWO
+	{0x11C53, charDigit | 3},    // BHAIKSUKI DIGIT THREE
+	{0x11C54, charDigit | 4},    // BHAIKSUKI DIGIT FOUR
+	{0x11C55, charDigit | 5},    // BHAIKSUKI DIGIT FIVE
+	{0x11C56, charDigit | 6},    // BHAIKSUKI DIGIT SIX
+	{0x11C57, charDigit | 7},    // BHAIKSUKI DIGIT SEVEN
+	{0x11C58, charDigit | 8},    // BHAIKSUKI DIGIT EIGHT
+	{0x11C59, charDigit | 9},    // BHAIKSUKI DIGIT NINE
+	{0x11D50, charDigit | 0},    // MASARAM GONDI DIGIT ZERO
+	{0x11D51, charDigit | 1},    // MASARAM GONDI DIGIT ONE
+	{0x11D52, charDigit | 2},    // MASARAM GONDI DIGIT TWO
+	{0x11D53, charDigit | 3},    // MASARAM GONDI DIGIT THREE
+	{0x11D54, charDigit | 4},    // MASARAM GONDI DIGIT FOUR
+	{0x11D55, charDigit | 5},    // MASARAM GONDI DIGIT FIVE
+	{0x11D56, charDigit | 6},    // MASARAM GONDI DIGIT SIX
+	{0x11D57, charDigit | 7},    // MASARAM GONDI DIGIT SEVEN
+	{0x11D58, charDigit | 8},    // MASARAM GONDI DIGIT EIGHT
+	{0x11D59, charDigit | 9},    // MASARAM GONDI DIGIT NINE
+	{0x11DA0, charDigit | 0},    // GUNJALA GONDI DIGIT ZERO
+	{0x11DA1, charDigit | 1},    // GUNJALA GONDI DIGIT ONE
+	{0x11DA2, charDigit | 2},    // GUNJALA GONDI DIGIT TWO
+	{0x11DA3, charDigit | 3},    // GUNJALA GONDI DIGIT THREE
+	{0x11DA4, charDigit | 4},    // GUNJALA GONDI DIGIT FOUR
+	{0x11DA5, charDigit | 5},    // GUNJALA GONDI DIGIT FIVE
+	{0x11DA6, charDigit | 6},    // GUNJALA GONDI DIGIT SIX
+	{0x11DA7, charDigit | 7},    // GUNJALA GONDI DIGIT SEVEN
+	{0x11DA8, charDigit | 8},    // GUNJALA GONDI DIGIT EIGHT
+	{0x11DA9, charDigit | 9},    // GUNJALA GONDI DIGIT NINE
+	{0x16A60, charDigit | 0},    // MRO DIGIT ZERO
+	{0x16A61, charDigit | 1},    // MRO DIGIT ONE
+	{0x16A62, charDigit | 2},    // MRO DIGIT TWO
+	{0x16A63, charDigit | 3},    // MRO DIGIT THREE
+	{0x16A64, charDigit | 4},    // MRO DIGIT FOUR
+	{0x16A65, charDigit | 5},    // MRO DIGIT FIVE
+	{0x16A66, charDigit | 6},    // MRO DIGIT SIX
+	{0x16A67, charDigit | 7},    // MRO DIGIT SEVEN
+	{0x16A68, charDigit | 8},    // MRO DIGIT EIGHT
+	{0x16A69, charDigit | 9},    // MRO DIGIT NINE
+	{0x16AC0, charDigit | 0},    // TANGSA DIGIT ZERO
+	{0x16AC1, charDigit | 1},    // TANGSA DIGIT ONE
+	{0x16AC2, charDigit | 2},    // TANGSA DIGIT TWO
+	{0x16AC3, charDigit | 3},    // TANGSA DIGIT THREE
+	{0x16AC4, charDigit | 4},    // TANGSA DIGIT FOUR
+	{0x16AC5, charDigit | 5},    // TANGSA DIGIT FIVE
+	{0x16AC6, charDigit | 6},    // TANGSA DIGIT SIX
+	{0x16AC7, charDigit | 7},    // TANGSA DIGIT SEVEN
+	{0x16AC8, charDigit | 8},    // TANGSA DIGIT EIGHT
+	{0x16AC9, charDigit | 9},    // TANGSA DIGIT NINE
+	{0x16B50, charDigit | 0},    // PAHAWH HMONG DIGIT ZERO
+	{0x16B51, charDigit | 1},    // PAHAWH HMONG DIGIT ONE
+	{0x16B52, charDigit | 2},    // PAHAWH HMONG DIGIT TWO
+	{0x16B53, charDigit | 3},    // PAHAWH HMONG DIGIT THREE
+	{0x16B54, charDigit | 4},    // PAHAWH HMONG DIGIT FOUR
+	{0x16B55, charDigit | 5},    // PAHAWH HMONG DIGIT FIVE
+	{0x16B56, charDigit | 6},    // PAHAWH HMONG DIGIT SIX
+	{0x16B57, charDigit | 7},    // PAHAWH HMONG DIGIT SEVEN
+	{0x16B58, charDigit | 8},    // PAHAWH HMONG DIGIT EIGHT
+	{0x16B59, charDigit | 9},    // PAHAWH HMONG DIGIT NINE
+	{0x1D7CE, charDigit | 0},    // MATHEMATICAL BOLD DIGIT ZERO
+	{0x1D7CF, charDigit | 1},    // MATHEMATICAL BOLD DIGIT ONE
+	{0x1D7D0, charDigit | 2},    // MATHEMATICAL BOLD DIGIT TWO
+	{0x1D7D1, charDigit | 3},    // MATHEMATICAL BOLD DIGIT THREE
+	{0x1D7D2, charDigit | 4},    // MATHEMATICAL BOLD DIGIT FOUR
+	{0x1D7D3, charDigit | 5},    // MATHEMATICAL BOLD DIGIT FIVE
+	{0x1D7D4, charDigit | 6},    // MATHEMATICAL BOLD DIGIT SIX
+	{0x1D7D5, charDigit | 7},    // MATHEMATICAL BOLD DIGIT SEVEN
+	{0x1D7D6, charDigit | 8},    // MATHEMATICAL BOLD DIGIT EIGHT
+	{0x1D7D7, charDigit | 9},    // MATHEMATICAL BOLD DIGIT NINE
+	{0x1D7D8, charDigit | 0},    // MATHEMATICAL DOUBLE-STRUCK DIGIT ZERO
+	{0x1D7D9, charDigit | 1},    // MATHEMATICAL DOUBLE-STRUCK DIGIT ONE
+	{0x1D7DA, charDigit | 2},    // MATHEMATICAL DOUBLE-STRUCK DIGIT TWO
+	{0x1D7DB, charDigit | 3},    // MATHEMATICAL DOUBLE-STRUCK DIGIT THREE
+	{0x1D7DC, charDigit | 4},    // MATHEMATICAL DOUBLE-STRUCK DIGIT FOUR
+	{0x1D7DD, charDigit | 5},    // MATHEMATICAL DOUBLE-STRUCK DIGIT FIVE
+	{0x1D7DE, charDigit | 6},    // MATHEMATICAL DOUBLE-STRUCK DIGIT SIX
+	{0x1D7DF, charDigit | 7},    // MATHEMATICAL DOUBLE-STRUCK DIGIT SEVEN
+	{0x1D7E0, charDigit | 8},    // MATHEMATICAL DOUBLE-STRUCK DIGIT EIGHT
+	{0x1D7E1, charDigit | 9},    // MATHEMATICAL DOUBLE-STRUCK DIGIT NINE
+	{0x1D7E2, charDigit | 0},    // MATHEMATICAL SANS-SERIF DIGIT ZERO
+	{0x1D7E3, charDigit | 1},    // MATHEMATICAL SANS-SERIF DIGIT ONE
+	{0x1D7E4, charDigit | 2},    // MATHEMATICAL SANS-SERIF DIGIT TWO
+	{0x1D7E5, charDigit | 3},    // MATHEMATICAL SANS-SERIF DIGIT THREE
+	{0x1D7E6, charDigit | 4},    // MATHEMATICAL SANS-SERIF DIGIT FOUR
+	{0x1D7E7, charDigit | 5},    // MATHEMATICAL SANS-SERIF DIGIT FIVE
+	{0x1D7E8, charDigit | 6},    // MATHEMATICAL SANS-SERIF DIGIT SIX
+	{0x1D7E9, charDigit | 7},    // MATHEMATICAL SANS-SERIF DIGIT SEVEN
+	{0x1D7EA, charDigit | 8},    // MATHEMATICAL SANS-SERIF DIGIT EIGHT
+	{0x1D7EB, charDigit | 9},    // MATHEMATICAL SANS-SERIF DIGIT NINE
+	{0x1D7EC, charDigit | 0},    // MATHEMATICAL SANS-SERIF BOLD DIGIT ZERO
+	{0x1D7ED, charDigit | 1},    // MATHEMATICAL SANS-SERIF BOLD DIGIT ONE
+	{0x1D7EE, charDigit | 2},    // MATHEMATICAL SANS-SERIF BOLD DIGIT TWO
+	{0x1D7EF, charDigit | 3},    // MATHEMATICAL SANS-SERIF BOLD DIGIT THREE
+	{0x1D7F0, charDigit | 4},    // MATHEMATICAL SANS-SERIF BOLD DIGIT FOUR
+	{0x1D7F1, charDigit | 5},    // MATHEMATICAL SANS-SERIF BOLD DIGIT FIVE
+	{0x1D7F2, charDigit | 6},    // MATHEMATICAL SANS-SERIF BOLD DIGIT SIX
+	{0x1D7F3, charDigit | 7},    // MATHEMATICAL SANS-SERIF BOLD DIGIT SEVEN
+	{0x1D7F4, charDigit | 8},    // MATHEMATICAL SANS-SERIF BOLD DIGIT EIGHT
+	{0x1D7F5, charDigit | 9},    // MATHEMATICAL SANS-SERIF BOLD DIGIT NINE
+	{0x1D7F6, charDigit | 0},    // MATHEMATICAL MONOSPACE DIGIT ZERO
+	{0x1D7F7, charDigit | 1},    // MATHEMATICAL MONOSPACE DIGIT ONE
+	{0x1D7F8, charDigit | 2},    // MATHEMATICAL MONOSPACE DIGIT TWO
+	{0x1D7F9, charDigit | 3},    // MATHEMATICAL MONOSPACE DIGIT THREE
+	{0x1D7FA, charDigit | 4},    // MATHEMATICAL MONOSPACE DIGIT FOUR
+	{0x1D7FB, charDigit | 5},    // MATHEMATICAL MONOSPACE DIGIT FIVE
+	{0x1D7FC, charDigit | 6},    // MATHEMATICAL MONOSPACE DIGIT SIX
+	{0x1D7FD, charDigit | 7},    // MATHEMATICAL MONOSPACE DIGIT SEVEN
+	{0x1D7FE, charDigit | 8},    // MATHEMATICAL MONOSPACE DIGIT EIGHT
+	{0x1D7FF, charDigit | 9},    // MATHEMATICAL MONOSPACE DIGIT NINE
+	{0x1E140, charDigit | 0},    // NYIAKENG PUACHUE HMONG DIGIT ZERO
+	{0x1E141, charDigit | 1},    // NYIAKENG PUACHUE HMONG DIGIT ONE
+	{0x1E142, charDigit | 2},    // NYIAKENG PUACHUE HMONG DIGIT TWO
+	{0x1E143, charDigit | 3},    // NYIAKENG PUACHUE HMONG DIGIT THREE
+	{0x1E144, charDigit | 4},    // NYIAKENG PUACHUE HMONG DIGIT FOUR
+	{0x1E145, charDigit | 5},    // NYIAKENG PUACHUE HMONG DIGIT FIVE
+	{0x1E146, charDigit | 6},    // NYIAKENG PUACHUE HMONG DIGIT SIX
+	{0x1E147, charDigit | 7},    // NYIAKENG PUACHUE HMONG DIGIT SEVEN
+	{0x1E148, charDigit | 8},    // NYIAKENG PUACHUE HMONG DIGIT EIGHT
+	{0x1E149, charDigit | 9},    // NYIAKENG PUACHUE HMONG DIGIT NINE
+	{0x1E2F0, charDigit | 0},    // WANCHO DIGIT ZERO
+	{0x1E2F1, charDigit | 1},    // WANCHO DIGIT ONE
+	{0x1E2F2, charDigit | 2},    // WANCHO DIGIT TWO
+	{0x1E2F3, charDigit | 3},    // WANCHO DIGIT THREE
+	{0x1E2F4, charDigit | 4},    // WANCHO DIGIT FOUR
+	{0x1E2F5, charDigit | 5},    // WANCHO DIGIT FIVE
+	{0x1E2F6, charDigit | 6},    // WANCHO DIGIT SIX
+	{0x1E2F7, charDigit | 7},    // WANCHO DIGIT SEVEN
+	{0x1E2F8, charDigit | 8},    // WANCHO DIGIT EIGHT
+	{0x1E2F9, charDigit | 9},    // WANCHO DIGIT NINE
+	{0x1E950, charDigit | 0},    // ADLAM DIGIT ZERO
+	{0x1E951, charDigit | 1},    // ADLAM DIGIT ONE
+	{0x1E952, charDigit | 2},    // ADLAM DIGIT TWO
+	{0x1E953, charDigit | 3},    // ADLAM DIGIT THREE
+	{0x1E954, charDigit | 4},    // ADLAM DIGIT FOUR
+	{0x1E955, charDigit | 5},    // ADLAM DIGIT FIVE
+	{0x1E956, charDigit | 6},    // ADLAM DIGIT SIX
+	{0x1E957, charDigit | 7},    // ADLAM DIGIT SEVEN
+	{0x1E958, charDigit | 8},    // ADLAM DIGIT EIGHT
+	{0x1E959, charDigit | 9},    // ADLAM DIGIT NINE
+	{0x1FBF0, charDigit | 0},    // SEGMENTED DIGIT ZERO
+	{0x1FBF1, charDigit | 1},    // SEGMENTED DIGIT ONE
+	{0x1FBF2, charDigit | 2},    // SEGMENTED DIGIT TWO
+	{0x1FBF3, charDigit | 3},    // SEGMENTED DIGIT THREE
+	{0x1FBF4, charDigit | 4},    // SEGMENTED DIGIT FOUR
+	{0x1FBF5, charDigit | 5},    // SEGMENTED DIGIT FIVE
+	{0x1FBF6, charDigit | 6},    // SEGMENTED DIGIT SIX
+	{0x1FBF7, charDigit | 7},    // SEGMENTED DIGIT SEVEN
+	{0x1FBF8, charDigit | 8},    // SEGMENTED DIGIT EIGHT
+	{0x1FBF9, charDigit | 9},    // SEGMENTED DIGIT NINE
+}
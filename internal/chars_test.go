@@ -0,0 +1,48 @@
+package internal
+
+import "testing"
+
+func TestNormalize_NormalizeCharacters(t *testing.T) {
+	tests := []struct {
+		name  string
+		lang  string
+		input string
+		want  string
+	}{
+		{name: "letter variants", input: "كيۀ", want: "کیه"},
+		{name: "presentation forms", input: "ﺳﻠﻡ ﺛﻚ", want: "سلم ثک"},
+		{name: "heh with yeh above forms", input: "ﮤﮥ", want: "هه"},
+		{name: "yeh with hamza above initial form", input: "ﺋ", want: "ی"},
+		{name: "digits of every script", input: "۱٢3๔५୬০௯", want: "12345609"},
+		{name: "superscript and subscript digits", input: "x³₇", want: "x37"},
+		{name: "digits in the configured language", lang: "fa", input: "12٣", want: "۱۲۳"},
+		{name: "punctuations", input: "?,;-", want: "؟،؛_"},
+		{name: "stripped marks", input: "بَﹰ‏", want: "ب\x00\x00\x00"},
+		{name: "look-alikes", input: "Ĩշ", want: "ا2"},
+		{name: "other characters", input: "abc 😀", want: "abc 😀"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Normalize{convertNumberLang: tt.lang}
+			if got := string(n.NormalizeCharacters(tt.input)); got != tt.want {
+				t.Errorf("NormalizeCharacters() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_lookupChar(t *testing.T) {
+	for i, m := range charMappings {
+		if i > 0 && charMappings[i-1].r >= m.r {
+			t.Fatalf("charMappings are not sorted at U+%04X", m.r)
+		}
+		if got := lookupChar(m.r); got != m.rule {
+			t.Errorf("lookupChar(U+%04X) = %x, want %x", m.r, got, m.rule)
+		}
+	}
+	for _, r := range []rune{-1, 'a', 'ی', 0x10FFFF, 0x110000} {
+		if got := lookupChar(r); got != 0 {
+			t.Errorf("lookupChar(U+%04X) = %x, want 0", r, got)
+		}
+	}
+}
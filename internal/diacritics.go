@@ -0,0 +1,37 @@
+package internal
+
+import "github.com/snapp-incubator/seperno/pkg/options"
+
+// hamzaAbove is the combining hamza, as in the ezafe of "خانهٔ"
+const hamzaAbove rune = 1620
+
+// diacriticForms maps the diacritics and their presentation forms to the combining diacritic they stand for.
+// The shadda ligatures keep only their shadda.
+var diacriticForms = map[rune]rune{
+	fatheh: fatheh, 65142: fatheh, 65143: fatheh,
+	zameh: zameh, 65144: zameh, 65145: zameh,
+	kasreh: kasreh, 65146: kasreh, 65147: kasreh,
+	sokun: sokun, 65150: sokun, 65151: sokun,
+	tashdid: tashdid, 65148: tashdid, 65149: tashdid, 64606: tashdid, 64607: tashdid, 64608: tashdid, 64609: tashdid, 64610: tashdid,
+	tanvinFatheh: tanvinFatheh, 65136: tanvinFatheh,
+	tanvinZameh: tanvinZameh, 65138: tanvinZameh,
+	tanvinKasreh: tanvinKasreh, 65140: tanvinKasreh,
+	alefLittle: alefLittle,
+	hamzaAbove: hamzaAbove,
+}
+
+// keptDiacritic returns the combining diacritic r stands for and whether the diacritics policy keeps it
+func (n Normalize) keptDiacritic(r rune) (rune, bool) {
+	mark, ok := diacriticForms[r]
+	if !ok {
+		return r, false
+	}
+	switch options.DiacriticsPolicy(n.diacritics) {
+	case options.DiacriticsKeep:
+		return mark, true
+	case options.DiacriticsKeepTashdidTanvin:
+		return mark, mark == tashdid || mark == tanvinFatheh || mark == tanvinZameh || mark == tanvinKasreh
+	default:
+		return r, false
+	}
+}
@@ -0,0 +1,31 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_diacritics(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy options.DiacriticsPolicy
+		input  string
+		want   string
+	}{
+		{name: "strip by default", input: "كِتابٌ مُحَمَّد", want: "کتاب محمد"},
+		{name: "strip", policy: options.DiacriticsStrip, input: "كِتابٌ مُحَمَّد", want: "کتاب محمد"},
+		{name: "keep", policy: options.DiacriticsKeep, input: "كِتابٌ مُحَمَّد", want: "کِتابٌ مُحَمَّد"},
+		{name: "keep tashdid and tanvin", policy: options.DiacriticsKeepTashdidTanvin, input: "كِتابٌ مُحَمَّد", want: "کتابٌ محمّد"},
+		{name: "presentation forms", policy: options.DiacriticsKeep, input: "بﹶبﹼ", want: "بَبّ"},
+		{name: "shadda ligature keeps its shadda", policy: options.DiacriticsKeepTashdidTanvin, input: "بﱠ", want: "بّ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Normalize{diacritics: string(tt.policy)}
+			if got := n.BasicNormalizer(tt.input); got != tt.want {
+				t.Errorf("BasicNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+// phrase is a phrase of a dictionary, its key is normalized like the text it is matched against
+type phrase struct {
+	key         []rune
+	replacement string
+}
+
+// phraseDictionary is the phrases of every dictionary of the options, ready to be matched
+type phraseDictionary struct {
+	// byFirst lists the phrases by the first rune of their key, longest key first
+	byFirst map[rune][]phrase
+	// innerWords are the words of the keys that another word follows, as in "اسنپ" of "اسنپ فود"
+	innerWords []string
+}
+
+// dictionaryRunes merges the rune mappings of dictionaries, a later dictionary wins over an earlier one
+func dictionaryRunes(dictionaries []options.Dictionary) map[rune]rune {
+	var runes map[rune]rune
+	for _, dict := range dictionaries {
+		for from, to := range dict.Runes {
+			if runes == nil {
+				runes = map[rune]rune{}
+			}
+			runes[from] = to
+		}
+	}
+	return runes
+}
+
+// hasPhrases reports whether one of dictionaries has phrases
+func hasPhrases(dictionaries []options.Dictionary) bool {
+	for _, dict := range dictionaries {
+		if len(dict.Phrases) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compilePhrases normalizes the keys of the phrases of dictionaries, or returns nil when there are none.
+// It must run once the rune mappings of n are set, since the keys go through NormalizeCharacters.
+func (n Normalize) compilePhrases(dictionaries []options.Dictionary) *phraseDictionary {
+	replacements := map[string]string{}
+	for _, dict := range dictionaries {
+		for key, replacement := range dict.Phrases {
+			if key = n.normalizeWords(key); key != "" {
+				replacements[key] = replacement
+			}
+		}
+	}
+	if len(replacements) == 0 {
+		return nil
+	}
+
+	dict := &phraseDictionary{byFirst: map[rune][]phrase{}}
+	inner := map[string]bool{}
+	for key, replacement := range replacements {
+		runes := []rune(key)
+		dict.byFirst[runes[0]] = append(dict.byFirst[runes[0]], phrase{key: runes, replacement: replacement})
+		words := strings.Split(key, " ")
+		for _, word := range words[:len(words)-1] {
+			inner[word] = true
+		}
+	}
+	for _, phrases := range dict.byFirst {
+		// longest key first, so the longest key wins
+		sort.Slice(phrases, func(i, j int) bool {
+			if len(phrases[i].key) != len(phrases[j].key) {
+				return len(phrases[i].key) > len(phrases[j].key)
+			}
+			return string(phrases[i].key) < string(phrases[j].key)
+		})
+	}
+	for word := range inner {
+		dict.innerWords = append(dict.innerWords, word)
+	}
+	sort.Strings(dict.innerWords)
+	return dict
+}
+
+// normalizeWords runs s through the steps that run before the dictionary step,
+// and writes the spaces between its words as a single space
+func (n Normalize) normalizeWords(s string) string {
+	text := NewText(s)
+	n.specialYehNormalizer(text)
+	n.spaceNormalizer(text)
+	n.characterNormalizer(text)
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+// dictionaryNormalizer replaces the words and phrases of the dictionaries, as in "خ." with "خیابان".
+// A key matches whole words only, a space of a key matches any run of spaces and the longest key wins.
+// Replacements are left alone by the steps after it. A key that starts or ends with a punctuation may be glued
+// to the word next to it, as in "خ.ولیعصر", so a space is written between it and the replacement.
+func (n Normalize) dictionaryNormalizer(text *Text) {
+	if n.phrases == nil {
+		return
+	}
+	var edits []edit
+	for i := 0; i < len(text.runes); {
+		r := text.runes[i]
+		if i > 0 && isPhraseWordRune(r) && isPhraseWordRune(text.runes[i-1]) {
+			i++
+			continue
+		}
+		matched := false
+		for _, p := range n.phrases.byFirst[r] {
+			end, ok := matchPhrase(text.runes, i, p.key)
+			if !ok || text.anyProtected(i, end) {
+				continue
+			}
+			if glued(text.runes, i-1, p.replacement, true) {
+				edits = append(edits, edit{start: i, end: i, replacement: " "})
+			}
+			edits = append(edits, edit{start: i, end: end, replacement: p.replacement, protect: true})
+			if glued(text.runes, end, p.replacement, false) {
+				edits = append(edits, edit{start: end, end: end, replacement: " "})
+			}
+			i, matched = end, true
+			break
+		}
+		if !matched {
+			i++
+		}
+	}
+	text.applyEdits(edits, false)
+}
+
+// matchPhrase reports whether key matches runes at start as whole words, and where the match ends
+func matchPhrase(runes []rune, start int, key []rune) (int, bool) {
+	i := start
+	for _, r := range key {
+		if r == ' ' {
+			if i >= len(runes) || !unicode.IsSpace(runes[i]) {
+				return 0, false
+			}
+			for i < len(runes) && unicode.IsSpace(runes[i]) {
+				i++
+			}
+			continue
+		}
+		if i >= len(runes) || runes[i] != r {
+			return 0, false
+		}
+		i++
+	}
+	if i < len(runes) && isPhraseWordRune(key[len(key)-1]) && isPhraseWordRune(runes[i]) {
+		return 0, false
+	}
+	return i, true
+}
+
+// glued reports whether the replacement would make one word with runes[neighbor], the rune before it
+// when before is set and the rune after it otherwise
+func glued(runes []rune, neighbor int, replacement string, before bool) bool {
+	if replacement == "" || neighbor < 0 || neighbor >= len(runes) || !isPhraseWordRune(runes[neighbor]) {
+		return false
+	}
+	edge, _ := utf8.DecodeLastRuneInString(replacement)
+	if before {
+		edge, _ = utf8.DecodeRuneInString(replacement)
+	}
+	return isPhraseWordRune(edge)
+}
+
+// isPhraseWordRune reports whether r is part of a word, the half space included so "روم" does not match
+// in "می‌روم"
+func isPhraseWordRune(r rune) bool {
+	return isWordRune(r) || r == spaceZeroWidthNonJoiner
+}
+
+// endsInnerWord reports whether the words before a space end with a word of a key that another word follows,
+// so a phrase may go on after the space
+func (d *phraseDictionary) endsInnerWord(word string) bool {
+	for _, inner := range d.innerWords {
+		if strings.HasSuffix(word, inner) {
+			return true
+		}
+	}
+	return false
+}
+
+// touches reports whether a key of the dictionary is written somewhere in word, whole words or not
+func (d *phraseDictionary) touches(word string) bool {
+	runes := []rune(word)
+	for i, r := range runes {
+		for _, p := range d.byFirst[r] {
+			if hasRunesAt(runes, i, p.key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasRunesAt reports whether runes has key at start
+func hasRunesAt(runes []rune, start int, key []rune) bool {
+	if start+len(key) > len(runes) {
+		return false
+	}
+	for i, r := range key {
+		if runes[start+i] != r {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_dictionaryNormalizer(t *testing.T) {
+	dictionaries := []options.Dictionary{
+		{Phrases: map[string]string{
+			"اسنپ فود": "اسنپ‌فود",
+			"خ.":       "خیابان",
+			"روم":      "رم",
+			"اسنپ":     "Snapp",
+		}},
+		{Phrases: map[string]string{"خ.": "خ"}},
+		{Phrases: map[string]string{"كوچه": "ک", ".com": "dot com"}},
+	}
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "phrase", input: "سفارش از اسنپ فود", want: "سفارش از اسنپ‌فود"},
+		{name: "runs of spaces", input: "اسنپ \t فود", want: "اسنپ‌فود"},
+		{name: "longest key wins", input: "اسنپ فود و اسنپ", want: "اسنپ‌فود و Snapp"},
+		{name: "later dictionary wins", input: "خ. ولیعصر", want: "خ ولیعصر"},
+		{name: "key is normalized", input: "کوچه دوم", want: "ک دوم"},
+		{name: "whole words only", input: "اسنپی رومی", want: "اسنپی رومی"},
+		{name: "half space joins words", input: "می‌روم", want: "می‌روم"},
+		{name: "punctuation ends a word", input: "(روم)", want: "(رم)"},
+		{name: "key glued to the next word", input: "خ.ولیعصر", want: "خ ولیعصر"},
+		{name: "key glued to the previous word", input: "سلام.com", want: "سلام dot com"},
+	}
+	n := NewNormalizer(options.NormalizerOptions{Dictionaries: dictionaries})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := NewText(tt.input)
+			n.dictionaryNormalizer(text)
+			if got := text.String(); got != tt.want {
+				t.Errorf("dictionaryNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize_dictionaryRunes(t *testing.T) {
+	n := NewNormalizer(options.NormalizerOptions{Dictionaries: []options.Dictionary{
+		{Runes: map[rune]rune{'ي': 'ى', 'ـ': '-'}},
+		{Runes: map[rune]rune{'ـ': 'ـ'}},
+		{Runes: map[rune]rune{'ے': 'ی'}},
+	}})
+	input := "عليـے"
+	text := NewText(input)
+	n.runSteps(text)
+	if got, want := text.String(), "علىـی"; got != want {
+		t.Errorf("BasicNormalizer(%q) = %q, want %q", input, got, want)
+	}
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+var (
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+	// urlRegex matches links with a scheme or "www." and bare domains with a known top-level domain.
+	// The path of a bare domain stops at Persian text, which is more likely glued to it than part of it.
+	urlRegex = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s]+|` +
+		`\b[a-z0-9](?:[a-z0-9\-]*[a-z0-9])?(?:\.[a-z0-9](?:[a-z0-9\-]*[a-z0-9])?)*` +
+		`\.(?:ir|com|net|org|io|co|me|info|app|dev|ai|edu|gov)\b(?:[/?#][^\s\p{Arabic}]*)?`)
+	mentionRegex = regexp.MustCompile(`@[A-Za-z0-9_](?:[A-Za-z0-9_.]*[A-Za-z0-9_])?`)
+	hashtagRegex = regexp.MustCompile(`#[\p{L}\p{M}\p{N}_\x{200c}]+`)
+)
+
+// urlTrailingPunctuations end a sentence rather than the link they follow
+const urlTrailingPunctuations = `.,;:!?)]}'"،؛؟»`
+
+// entity is an entity found in a text, Start and End are the rune indices [Start, End) it covers
+type entity struct {
+	Start int
+	End   int
+	Kind  options.EntityKind
+}
+
+// entityNormalizer removes, replaces or extracts the URLs, e-mails, mentions and hashtags of the text.
+// Replacement tokens and extracted entities are protected, so the steps after it leave them as they are.
+func (n Normalize) entityNormalizer(text *Text) {
+	found := findEntities(text.String())
+	edits := make([]edit, 0, len(found))
+	for _, e := range found {
+		mode, ok := n.entityMode(e.Kind)
+		if !ok || text.anyProtected(e.Start, e.End) {
+			continue
+		}
+		if n.entityReport != nil {
+			n.entityReport(options.Entity{
+				Kind: e.Kind,
+				Mode: mode,
+				Text: string(text.runes[e.Start:e.End]),
+				Span: text.rangeSpan(e.Start, e.End),
+			})
+		}
+		switch mode {
+		case options.EntityRemove:
+			edits = append(edits, edit{start: e.Start, end: e.End})
+		case options.EntityReplace:
+			edits = append(edits, edit{start: e.Start, end: e.End, replacement: n.entityToken(e.Kind), protect: true})
+		case options.EntityExtract:
+			edits = append(edits, edit{start: e.Start, end: e.End, keep: true, protect: true})
+		}
+	}
+	text.applyEdits(edits, false)
+}
+
+// entityMode returns what the handler does with the given kind of entity and whether it handles it at all
+func (n Normalize) entityMode(kind options.EntityKind) (options.EntityMode, bool) {
+	if mode, ok := n.entities[kind]; ok {
+		return mode, true
+	}
+	return "", false
+}
+
+// entityToken returns the configured token of kind, or its default one
+func (n Normalize) entityToken(kind options.EntityKind) string {
+	if token, ok := n.entityTokens[kind]; ok {
+		return token
+	}
+	return options.DefaultEntityTokens[kind]
+}
+
+// findEntities finds the entities of s sorted by position. Every kind is looked for even when it is not handled,
+// so "@snapp" in "info@snapp.ir" or "#top" in a link are not taken for a mention or a hashtag.
+func findEntities(s string) []entity {
+	runes := []rune(s)
+	var found []entity
+	add := func(re *regexp.Regexp, kind options.EntityKind, accept func(start, end int) (int, bool)) {
+		for _, m := range re.FindAllStringIndex(s, -1) {
+			start := utf8.RuneCountInString(s[:m[0]])
+			end, ok := accept(start, start+utf8.RuneCountInString(s[m[0]:m[1]]))
+			if !ok || overlapsEntity(found, start, end) {
+				continue
+			}
+			found = append(found, entity{Start: start, End: end, Kind: kind})
+		}
+	}
+	// mentions and hashtags start a word
+	startsWord := func(start, end int) (int, bool) {
+		return end, start == 0 || !isEntityWordRune(runes[start-1])
+	}
+
+	add(emailRegex, options.EntityEmail, func(start, end int) (int, bool) {
+		return end, true
+	})
+	add(urlRegex, options.EntityURL, func(start, end int) (int, bool) {
+		if start > 0 && (runes[start-1] == '@' || runes[start-1] == '.') {
+			return end, false
+		}
+		for end > start && strings.ContainsRune(urlTrailingPunctuations, runes[end-1]) {
+			end--
+		}
+		return end, end > start
+	})
+	add(mentionRegex, options.EntityMention, startsWord)
+	add(hashtagRegex, options.EntityHashtag, startsWord)
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Start < found[j].Start
+	})
+	return found
+}
+
+func overlapsEntity(found []entity, start, end int) bool {
+	for _, e := range found {
+		if start < e.End && e.Start < end {
+			return true
+		}
+	}
+	return false
+}
+
+func isEntityWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func Test_findEntities(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []entity
+	}{
+		{
+			name:  "link with a scheme",
+			input: "برو https://snapp.ir/ride?id=1.",
+			want:  []entity{{Start: 4, End: 30, Kind: options.EntityURL}},
+		},
+		{
+			name:  "www and bare domains",
+			input: "www.Snapp.ir و snapp.ir/ride",
+			want:  []entity{{Start: 0, End: 12, Kind: options.EntityURL}, {Start: 15, End: 28, Kind: options.EntityURL}},
+		},
+		{
+			name:  "email is not a mention or a domain",
+			input: "ایمیل info@snapp.ir است",
+			want:  []entity{{Start: 6, End: 19, Kind: options.EntityEmail}},
+		},
+		{
+			name:  "mention and persian hashtag",
+			input: "@snapp_food #هشتگ‌ها",
+			want:  []entity{{Start: 0, End: 11, Kind: options.EntityMention}, {Start: 12, End: 20, Kind: options.EntityHashtag}},
+		},
+		{
+			name:  "persian text glued to a bare domain",
+			input: "snapp.ir/rideبرو",
+			want:  []entity{{Start: 0, End: 13, Kind: options.EntityURL}},
+		},
+		{
+			name:  "hashtag inside a link",
+			input: "(https://snapp.ir/#top)",
+			want:  []entity{{Start: 1, End: 22, Kind: options.EntityURL}},
+		},
+		{
+			name:  "not inside a word",
+			input: "a#b c@d 2.5 file.txt",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findEntities(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findEntities() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize_entityNormalizer(t *testing.T) {
+	n := Normalize{
+		entities: map[options.EntityKind]options.EntityMode{
+			options.EntityURL:     options.EntityExtract,
+			options.EntityEmail:   options.EntityReplace,
+			options.EntityMention: options.EntityRemove,
+		},
+		normalizePunctuations: true,
+		spaceCombiner:         true,
+	}
+	input := "سلام @Ali! لینک HTTPS://Snapp.ir/Ride_1 و ایمیل A.B@Snapp.ir #تست"
+	want := "سلام لینک HTTPS://Snapp.ir/Ride_1 و ایمیل [EMAIL] #تست"
+	if got := n.BasicNormalizer(input); got != want {
+		t.Errorf("BasicNormalizer() = %v, want %v", got, want)
+	}
+}
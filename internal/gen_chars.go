@@ -0,0 +1,316 @@
+//go:build ignore
+
+// gen_chars generates chars_table.go, the character table of NormalizeCharacters, from the Unicode
+// Character Database and the hand-kept rules below.
+//
+// Besides the rules below, the table has
+//   - every decimal digit (Nd), and the superscript and subscript digits, as a digit of its value,
+//   - every Arabic presentation form whose compatibility decomposition is a single letter once a leading
+//     space or tatweel and the stripped marks are dropped, as the rule of that letter.
+//     Forms made only of stripped marks, such as "ﹰ", are stripped.
+//
+// Usage:
+//
+//	go run gen_chars.go [-version 14.0.0] [-ucd path/or/url/to/UnicodeData.txt]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// letters are folded into the first letter of their group.
+// Their presentation forms are found from the Unicode data.
+var letters = []string{
+	"اآأإٱٲٵ",
+	"بٮݕ",
+	"پݐݒ",
+	"تٹٺټٿݓ",
+	"ثٽݑ",
+	"جڃ",
+	"چڇڿݘ",
+	"حځ",
+	"خڂݗ",
+	"دڈډڊڋڌڍڐۮ",
+	"رڑڒړڔڕږۯݛݬ",
+	"زڗݫ",
+	"سښڛݭ",
+	"شڜۺݜ",
+	"صڝ",
+	"ضۻ",
+	"ظڟ",
+	"عڠ",
+	"غۼݞݟ",
+	"فڢڣڤڥ",
+	"قڦڧڨ",
+	"کكڪګڬڭڮػݢݣݤ",
+	"گڰڱڲڳڴ",
+	"لڵڶڷڸݪ",
+	"م۾ݥ",
+	"نڹںڻݧ",
+	"وؤٶٷۄۅۆۇۈۉۊۋۏ",
+	"هةۀۂۃھہەۿ",
+	"یىئيٸۍېۑےؽؿ",
+}
+
+// lookAlikes are characters of other scripts or signs that are written in place of a letter or a digit
+var lookAlikes = map[rune]rune{
+	'Ĩ': 'ا', // LATIN CAPITAL LETTER I WITH TILDE
+	'շ': '2', // ARMENIAN SMALL LETTER SHA
+	'؋': 'ف', // AFGHANI SIGN
+	'؏': 'ع', // ARABIC SIGN MISRA
+	'ވ': 'و', // THAANA LETTER VAAVU
+	'ᓅ': 'ف', // CANADIAN SYLLABICS
+	'טּ': 'ن', // HEBREW LETTER TET WITH DAGESH
+}
+
+// punctuations are replaced with their Persian forms, and dashes with an underscore
+var punctuations = map[rune]rune{
+	'?': '؟', '%': '٪', ';': '؛', '：': ':', ',': '،', '٬': '،',
+	'-': '_', '\u00AD': '_', '˗': '_', '־': '_', 'ـ': '_', '–': '_', '—': '_', '─': '_', '━': '_', '➖': '_',
+	'┄': '…', '┅': '…', '┈': '…',
+}
+
+// stripped are removed: diacritics, combining marks, the hamza and direction marks
+var stripped = []rune{
+	// Arabic marks and the hamza
+	0x0621, 0x064B, 0x064C, 0x064D, 0x064E, 0x064F, 0x0650, 0x0651, 0x0652, 0x0654, 0x0656, 0x0670, 0x0674, 0x06FD,
+	// combining marks
+	0x0300, 0x0301, 0x0303, 0x0304, 0x0305, 0x0307, 0x0308, 0x030C, 0x030D, 0x0310, 0x0311, 0x0312, 0x031A, 0x031C,
+	0x031D, 0x031F, 0x0321, 0x0323, 0x0324, 0x0325, 0x0326, 0x0327, 0x0328, 0x0329, 0x032C, 0x032D, 0x032E, 0x032F,
+	0x0330, 0x0332, 0x0336, 0x0338, 0x033A, 0x033C, 0x033E, 0x0347, 0x034E, 0x034F, 0x0352, 0x0359, 0x035B, 0x035C,
+	0x035D, 0x035E, 0x035F, 0x0362, 0x036F, 0x1DC2, 0x1DC4, 0x1DC5, 0x20D9, 0x20DA, 0x20DF,
+	// direction marks and the noncharacter U+FFFF
+	0x200E, 0x200F, 0xFFFF,
+}
+
+type rule struct {
+	action string // "charReplace", "charDigit" or "charStrip"
+	value  rune
+}
+
+type char struct {
+	name          string
+	decomposition string
+	digit         int // the decimal digit value, -1 for other characters
+}
+
+var ucd = map[rune]char{}
+
+func main() {
+	version := flag.String("version", "14.0.0", "Unicode version")
+	source := flag.String("ucd", "", "path or URL of UnicodeData.txt, the one of -version on unicode.org when empty")
+	output := flag.String("output", "chars_table.go", "output file")
+	flag.Parse()
+	if *source == "" {
+		*source = "https://www.unicode.org/Public/" + *version + "/ucd/UnicodeData.txt"
+	}
+
+	if err := readUCD(*source); err != nil {
+		log.Fatal(err)
+	}
+	rules := buildRules()
+
+	runes := make([]rune, 0, len(rules))
+	blocks := map[rune]bool{}
+	for r := range rules {
+		runes = append(runes, r)
+		blocks[r>>8] = true
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	if len(blocks) > 255 {
+		log.Fatalf("%d blocks of 256 runes have rules, the lookup table has room for 255", len(blocks))
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_chars.go from Unicode %s data. DO NOT EDIT.\n\n", *version)
+	b.WriteString("package internal\n\n")
+	b.WriteString("// charMappings are the rules of NormalizeCharacters sorted by rune\n")
+	b.WriteString("var charMappings = [...]charMapping{\n")
+	for _, r := range runes {
+		rl := rules[r]
+		name := ucd[r].name
+		if name == "" {
+			name = "<noncharacter>"
+		}
+		switch rl.action {
+		case "charStrip":
+			fmt.Fprintf(&b, "{0x%04X, charStrip}, // %s\n", r, name)
+		case "charDigit":
+			fmt.Fprintf(&b, "{0x%04X, charDigit | %d}, // %s\n", r, rl.value, name)
+		default:
+			fmt.Fprintf(&b, "{0x%04X, charReplace | %q}, // %s\n", r, rl.value, name)
+		}
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readUCD reads the names, decompositions and digit values of UnicodeData.txt
+func readUCD(source string) error {
+	var r io.Reader
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s: %s", source, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) < 15 {
+			continue
+		}
+		code, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			return err
+		}
+		c := char{name: fields[1], decomposition: fields[5], digit: -1}
+		if fields[2] == "Nd" {
+			if c.digit, err = strconv.Atoi(fields[6]); err != nil {
+				return fmt.Errorf("%s: %w", fields[0], err)
+			}
+		}
+		ucd[rune(code)] = c
+	}
+	return scanner.Err()
+}
+
+// buildRules returns the rule of every rune NormalizeCharacters changes
+func buildRules() map[rune]rule {
+	seeds := map[rune]rule{}
+	seed := func(r rune, rl rule) {
+		if _, ok := seeds[r]; ok {
+			log.Fatalf("U+%04X has more than one rule", r)
+		}
+		seeds[r] = rl
+	}
+	for _, group := range letters {
+		to := []rune(group)[0]
+		for _, r := range group {
+			if r != to {
+				seed(r, rule{"charReplace", to})
+			}
+		}
+	}
+	for r, to := range lookAlikes {
+		if to >= '0' && to <= '9' {
+			seed(r, rule{"charDigit", to - '0'})
+		} else {
+			seed(r, rule{"charReplace", to})
+		}
+	}
+	for r, to := range punctuations {
+		seed(r, rule{"charReplace", to})
+	}
+	for _, r := range stripped {
+		seed(r, rule{action: "charStrip"})
+	}
+
+	rules := map[rune]rule{}
+	for r := range seeds {
+		rules[r] = seeds[r]
+	}
+	for r := range ucd {
+		if rl, ok := resolve(r, seeds); ok {
+			rules[r] = rl
+		}
+	}
+	return rules
+}
+
+// resolve returns the rule of r
+func resolve(r rune, seeds map[rune]rule) (rule, bool) {
+	if rl, ok := seeds[r]; ok {
+		return rl, true
+	}
+	c, ok := ucd[r]
+	if !ok {
+		return rule{}, false
+	}
+	if c.digit >= 0 {
+		return rule{"charDigit", rune(c.digit)}, true
+	}
+
+	tag, parts := decompose(c.decomposition)
+	presentation := (r >= 0xFB50 && r <= 0xFDFF) || (r >= 0xFE70 && r <= 0xFEFF)
+	script := tag == "<super>" || tag == "<sub>"
+	if !presentation && !script {
+		return rule{}, false
+	}
+
+	// drop a leading space or tatweel and the stripped marks
+	if len(parts) > 1 && (parts[0] == ' ' || parts[0] == 'ـ') {
+		parts = parts[1:]
+	}
+	kept := parts[:0:0]
+	for _, p := range parts {
+		if rl, ok := seeds[p]; !ok || rl.action != "charStrip" {
+			kept = append(kept, p)
+		}
+	}
+	switch {
+	case presentation && len(parts) > 0 && len(kept) == 0:
+		return rule{action: "charStrip"}, true
+	case len(kept) != 1:
+		return rule{}, false
+	}
+
+	rl, ok := resolve(kept[0], seeds)
+	switch {
+	case script && rl.action != "charDigit":
+		return rule{}, false
+	case ok:
+		return rl, true
+	default:
+		return rule{"charReplace", kept[0]}, true
+	}
+}
+
+// decompose splits a compatibility decomposition such as "<isolated> 0627" into its tag and runes.
+// Canonical decompositions have no tag and are not used.
+func decompose(decomposition string) (string, []rune) {
+	fields := strings.Fields(decomposition)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "<") {
+		return "", nil
+	}
+	runes := make([]rune, 0, len(fields)-1)
+	for _, f := range fields[1:] {
+		code, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			log.Fatalf("decomposition %q: %v", decomposition, err)
+		}
+		runes = append(runes, rune(code))
+	}
+	return fields[0], runes
+}
@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"strings"
+	"unicode"
+)
+
+// halfSpacePrefixes are the verb prefixes written with a half space before the verb, as in "می‌خواهم"
+var halfSpacePrefixes = map[string]bool{"می": true, "نمی": true}
+
+// halfSpaceSuffixes are the plural and comparative suffixes written with a half space after the word,
+// as in "کتاب‌ها" or "بزرگ‌ترین"
+var halfSpaceSuffixes = map[string]bool{
+	"ها": true, "های": true, "هایی": true,
+	"هایم": true, "هایت": true, "هایش": true, "هایمان": true, "هایتان": true, "هایشان": true,
+	"تر": true, "تری": true, "ترین": true,
+}
+
+// halfSpacePronouns are the pronoun and verb suffixes written with a half space after a word that ends with "ه",
+// as in "خانه‌ام" or "رفته‌اند". After other letters they are glued to the word, so spaced ones are left alone.
+var halfSpacePronouns = map[string]bool{
+	"ام": true, "ات": true, "اش": true, "ایم": true, "اید": true, "اند": true,
+	"مان": true, "تان": true, "شان": true,
+}
+
+// halfSpaceAdjectives are the common adjectives joined to "تر". On its own "تر" also means wet, as in "لباس تر",
+// so it is joined only after them, while "تری" and "ترین" are joined after any word.
+var halfSpaceAdjectives = map[string]bool{
+	"بزرگ": true, "کوچک": true, "بیش": true, "کم": true, "خوب": true, "بد": true, "بلند": true, "کوتاه": true,
+	"سریع": true, "زیاد": true, "مهم": true, "ساده": true, "سخت": true, "آسان": true, "ارزان": true, "گران": true,
+	"نزدیک": true, "دور": true, "بالا": true, "پایین": true, "جدید": true, "تازه": true, "قدیمی": true, "راحت": true,
+	"سنگین": true, "سبک": true, "گرم": true, "سرد": true, "تند": true, "کند": true, "قوی": true, "ضعیف": true,
+	"زیبا": true, "زشت": true, "جوان": true, "پیر": true, "مناسب": true, "دقیق": true,
+	"روشن": true, "تاریک": true, "پر": true, "خالی": true, "ارزشمند": true, "مطمئن": true, "سالم": true, "شلوغ": true,
+	"خلوت": true, "پهن": true, "باریک": true, "عمیق": true, "چاق": true, "لاغر": true, "ساکت": true, "آرام": true,
+}
+
+// halfSpaceNotComparable are the pronouns and demonstratives, which never take a comparative suffix, as in "ما تر"
+var halfSpaceNotComparable = map[string]bool{
+	"من": true, "تو": true, "او": true, "ما": true, "شما": true, "آنها": true, "ایشان": true, "وی": true,
+	"این": true, "آن": true, "اینها": true, "آنان": true, "همه": true, "هیچ": true,
+}
+
+// halfSpaceStopWords are words that are never joined to a suffix or a prefix
+var halfSpaceStopWords = map[string]bool{
+	"و": true, "در": true, "به": true, "از": true, "که": true, "را": true, "با": true, "هم": true, "یا": true,
+	"تا": true, "بر": true, "هر": true, "چه": true, "اگر": true, "نه": true, "یک": true, "بی": true,
+}
+
+// halfSpaceFixerNormalizer replaces the spaces between a word and its prefix or suffix with a half space,
+// as in "می خواهم" and "کتاب ها". Words with digits or other scripts are left alone.
+func (n Normalize) halfSpaceFixerNormalizer(text *Text) {
+	words := persianWords(text.runes)
+	var ranges [][2]int
+	for i := 1; i < len(words); i++ {
+		left, right := words[i-1], words[i]
+		if !onlySpaces(text.runes[left[1]:right[0]]) {
+			continue
+		}
+		if joinsWithHalfSpace(string(text.runes[left[0]:left[1]]), string(text.runes[right[0]:right[1]])) {
+			ranges = append(ranges, [2]int{left[1], right[0]})
+		}
+	}
+	text.replaceRuneRanges(ranges, func(int) string {
+		return string(spaceZeroWidthNonJoiner)
+	})
+}
+
+// joinsWithHalfSpace reports whether the words left and right, written with a space between them,
+// belong together with a half space
+func joinsWithHalfSpace(left, right string) bool {
+	if halfSpaceStopWords[left] || halfSpaceStopWords[right] {
+		return false
+	}
+	switch {
+	case halfSpacePrefixes[left]:
+		return len([]rune(right)) >= 2 && !halfSpaceSuffixes[right] && !halfSpacePronouns[right]
+	case halfSpacePrefixes[right]:
+		return false
+	case right == "تر":
+		return halfSpaceAdjectives[left]
+	case strings.HasPrefix(right, "تر") && halfSpaceNotComparable[left]:
+		return false
+	case halfSpaceSuffixes[right]:
+		return len([]rune(left)) >= 2
+	case halfSpacePronouns[right]:
+		return len([]rune(left)) >= 2 && strings.HasSuffix(left, "ه")
+	}
+	return false
+}
+
+// persianWords returns the rune ranges [start, end) of the words of runes that are made of Persian letters only
+func persianWords(runes []rune) [][2]int {
+	var words [][2]int
+	for start := 0; start < len(runes); {
+		end := start
+		persian := true
+		for end < len(runes) && isWordRune(runes[end]) {
+			persian = persian && (isPersianLetter(runes[end]) || unicode.IsMark(runes[end]))
+			end++
+		}
+		if end == start {
+			start++
+			continue
+		}
+		if persian {
+			words = append(words, [2]int{start, end})
+		}
+		start = end
+	}
+	return words
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+func isPersianLetter(r rune) bool {
+	return unicode.IsLetter(r) && unicode.Is(unicode.Arabic, r)
+}
+
+func onlySpaces(runes []rune) bool {
+	for _, r := range runes {
+		if r != ' ' {
+			return false
+		}
+	}
+	return len(runes) > 0
+}
@@ -0,0 +1,35 @@
+package internal
+
+import "testing"
+
+func TestNormalize_halfSpaceFixerNormalizer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "verb prefix", input: "من می خواهم بروم", want: "من می‌خواهم بروم"},
+		{name: "negative verb prefix", input: "نمی دانم", want: "نمی‌دانم"},
+		{name: "plural suffix", input: "کتاب ها و دفتر های من", want: "کتاب‌ها و دفتر‌های من"},
+		{name: "comparative suffixes", input: "بزرگ تر از بزرگ ترین", want: "بزرگ‌تر از بزرگ‌ترین"},
+		{name: "wet after a noun", input: "لباس تر و دست تر", want: "لباس تر و دست تر"},
+		{name: "comparative after a pronoun", input: "ما تر و شما ترین", want: "ما تر و شما ترین"},
+		{name: "superlative after any word", input: "مهربان ترین", want: "مهربان‌ترین"},
+		{name: "pronoun suffix after heh", input: "خانه ام و نامه شان", want: "خانه‌ام و نامه‌شان"},
+		{name: "pronoun suffix after other letters", input: "کتاب ام", want: "کتاب ام"},
+		{name: "several spaces", input: "می   روم", want: "می‌روم"},
+		{name: "stop words", input: "از ها و می و", want: "از ها و می و"},
+		{name: "other scripts and digits", input: "abc ها 12 تر می 5", want: "abc ها 12 تر می 5"},
+		{name: "already joined", input: "می‌خواهم کتاب‌ها", want: "می‌خواهم کتاب‌ها"},
+		{name: "not a suffix", input: "کتاب هاشمی", want: "کتاب هاشمی"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := NewText(tt.input)
+			Normalize{}.halfSpaceFixerNormalizer(text)
+			if got := text.String(); got != tt.want {
+				t.Errorf("halfSpaceFixerNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
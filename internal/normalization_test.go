@@ -1,7 +1,10 @@
 package internal
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
 )
 
 func TestNormalize_BasicNormalizer(t *testing.T) {
@@ -183,3 +186,149 @@ func Test_removeURLs(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalize_BasicNormalizerWithOffsets(t *testing.T) {
+	type span struct {
+		output string
+		input  string
+	}
+	tests := []struct {
+		name  string
+		n     Normalize
+		input string
+		want  string
+		spans []span
+	}{
+		{
+			name:  "special yeh inserts a space mapped to the yeh",
+			n:     Normalize{},
+			input: "ے‌ب",
+			want:  "ی ‌ب",
+			spans: []span{{output: "ی ", input: "ے"}, {output: "ب", input: "ب"}},
+		},
+		{
+			name:  "diacritics are removed",
+			n:     Normalize{},
+			input: "کَتاب",
+			want:  "کتاب",
+			spans: []span{{output: "کتاب", input: "کَتاب"}, {output: "ت", input: "ت"}},
+		},
+		{
+			name:  "html half space collapses to one space",
+			n:     Normalize{convertHalfSpaceToSpace: true},
+			input: "می&zwnj;روم",
+			want:  "می روم",
+			spans: []span{{output: " ", input: "&zwnj;"}, {output: "روم", input: "روم"}},
+		},
+		{
+			name:  "url removal and space combining",
+			n:     Normalize{urlRemover: true, spaceCombiner: true},
+			input: "سلام https://example.com   دنیا",
+			want:  "سلام دنیا",
+			spans: []span{{output: "دنیا", input: "دنیا"}, {output: "سلام", input: "سلام"}},
+		},
+		{
+			name:  "lam-alef ligature becomes two letters",
+			n:     Normalize{},
+			input: "ﺳﻼﻡ ﻷ",
+			want:  "سلام لا",
+			spans: []span{{output: "لا", input: "ﻼ"}, {output: "م", input: "ﻡ"}},
+		},
+		{
+			name:  "number spelled from the digits",
+			n:     Normalize{intToWord: true},
+			input: "کوچه ۱۱۰",
+			want:  "کوچه صد و ده",
+			spans: []span{{output: "صد و ده", input: "۱۱۰"}, {output: "کوچه", input: "کوچه"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, offsets := tt.n.BasicNormalizerWithOffsets(tt.input)
+			if got != tt.want {
+				t.Fatalf("BasicNormalizerWithOffsets() = %v, want %v", got, tt.want)
+			}
+			if offsets.Len() != len([]rune(got)) {
+				t.Fatalf("offsets cover %d runes, output has %d", offsets.Len(), len([]rune(got)))
+			}
+			inputRunes, gotRunes := []rune(tt.input), []rune(got)
+			for _, s := range tt.spans {
+				start := strings.Index(got, s.output)
+				if start < 0 {
+					t.Fatalf("%q not found in output", s.output)
+				}
+				outStart := len([]rune(got[:start]))
+				outEnd := outStart + len([]rune(s.output))
+				span := offsets.Range(outStart, outEnd)
+				if in := string(inputRunes[span.Start:span.End]); in != s.input {
+					t.Errorf("%q maps to %q, want %q", string(gotRunes[outStart:outEnd]), in, s.input)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalize_BasicNormalizerWithOffsetsMatchesBasicNormalizer(t *testing.T) {
+	inputs := []string{
+		"",
+		"  تست   https://example.com  ",
+		"سلام,خوبی؟چه خبرا.",
+		"ﻩ ے کتاب‌ها&zwnj;ی من\n۱۲۳",
+		"کوچه 110 پلاک ۲۰.",
+	}
+	normalizers := []Normalize{
+		{},
+		{convertHalfSpaceToSpace: true, spaceCombiner: true},
+		{urlRemover: true, outerSpaceRemover: true, normalizePunctuations: true},
+		{endsWithEndOfLineChar: true, intToWord: true, convertNumberLang: "fa"},
+	}
+	for _, n := range normalizers {
+		for _, input := range inputs {
+			got, offsets := n.BasicNormalizerWithOffsets(input)
+			if want := n.BasicNormalizer(input); got != want {
+				t.Errorf("BasicNormalizerWithOffsets(%q) = %q, want %q", input, got, want)
+			}
+			if offsets.Len() != len([]rune(got)) {
+				t.Errorf("offsets of %q cover %d runes, output has %d", input, offsets.Len(), len([]rune(got)))
+			}
+			for i := 0; i < offsets.Len(); i++ {
+				if s := offsets.Span(i); s.Start < 0 || s.End > len([]rune(input)) || s.Start >= s.End {
+					t.Errorf("invalid span %v for rune %d of %q", s, i, got)
+				}
+			}
+		}
+	}
+}
+
+func TestNewNormalizer_PanicsWithoutDetector(t *testing.T) {
+	tests := []struct {
+		name string
+		conf options.NormalizerOptions
+	}{
+		{name: "word to int", conf: options.NormalizerOptions{WordToInt: true}},
+		{name: "phone", conf: options.NormalizerOptions{Phone: true}},
+		{name: "pii masker", conf: options.NormalizerOptions{PIIMasker: true}},
+		{name: "phone in custom steps", conf: options.NormalizerOptions{Steps: []options.Step{BuiltinStep(StepPhone)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewNormalizer() did not panic without a detector")
+				}
+			}()
+			NewNormalizer(tt.conf)
+		})
+	}
+}
+
+func TestNewNormalizer_BuildsPipelineOnce(t *testing.T) {
+	n := NewNormalizer(options.NormalizerOptions{SpaceCombiner: true, URLRemover: true})
+	first, second := n.pipeline(), n.pipeline()
+	if &first[0] != &second[0] {
+		t.Errorf("pipeline() builds the steps again on every call")
+	}
+	if got, want := len(first), len(DefaultSteps(options.NormalizerOptions{SpaceCombiner: true, URLRemover: true})); got != want {
+		t.Errorf("pipeline() has %d steps, want %d", got, want)
+	}
+}
@@ -1,5 +1,7 @@
 package internal
 
+import "strings"
+
 const (
 	// Persian Numbers
 	faD0 = '\u06F0'
@@ -80,3 +82,26 @@ func convertToDestNumber(input int32, lang string) rune {
 		return input
 	}
 }
+
+// convertDigits converts the English digits of input to the configured language
+func (n Normalize) convertDigits(input string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= enD0 && r <= enD9 {
+			return convertToDestNumber(r, n.convertNumberLang)
+		}
+		return r
+	}, input)
+}
+
+// toEnglishDigits converts Persian and Arabic digits to English digits
+func toEnglishDigits(input string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= faD0 && r <= faD9:
+			return enD0 + r - faD0
+		case r >= arD0 && r <= arD9:
+			return enD0 + r - arD0
+		}
+		return r
+	}, input)
+}
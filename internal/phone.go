@@ -0,0 +1,19 @@
+package internal
+
+import "github.com/snapp-incubator/seperno/pkg/options"
+
+// phoneNormalizer writes every phone number in the configured format, with digits in the configured language.
+// Phone numbers are protected, so the steps after it do not read their digits as numbers.
+func (n Normalize) phoneNormalizer(text *Text) {
+	s := text.String()
+	phones := n.phoneDetector(s)
+	edits := make([]edit, 0, len(phones))
+	for _, phone := range phones {
+		replacement := phone.E164
+		if n.phoneFormat == string(options.PhoneFormatNational) {
+			replacement = phone.National
+		}
+		edits = append(edits, edit{start: phone.Start, end: phone.End + 1, replacement: n.convertDigits(replacement), protect: true})
+	}
+	text.applyEdits(edits, false)
+}
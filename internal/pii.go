@@ -0,0 +1,33 @@
+package internal
+
+import "github.com/snapp-incubator/seperno/pkg/options"
+
+// piiNormalizer replaces personal data with the placeholder of its kind and reports what it replaced.
+// Placeholders are protected, so the steps after it leave them as they are.
+func (n Normalize) piiNormalizer(text *Text) {
+	s := text.String()
+	found := n.piiDetector(s)
+	edits := make([]edit, 0, len(found))
+	for _, p := range found {
+		if text.anyProtected(p.Start, p.End+1) { // left as it is, so neither masked nor reported
+			continue
+		}
+		edits = append(edits, edit{start: p.Start, end: p.End + 1, replacement: n.piiPlaceholder(p.Kind), protect: true})
+		if n.piiReport != nil {
+			n.piiReport(options.MaskedPII{
+				Kind: p.Kind,
+				Text: string(text.runes[p.Start : p.End+1]),
+				Span: text.rangeSpan(p.Start, p.End+1),
+			})
+		}
+	}
+	text.applyEdits(edits, false)
+}
+
+// piiPlaceholder returns the configured placeholder of kind, or its default one
+func (n Normalize) piiPlaceholder(kind options.PIIKind) string {
+	if placeholder, ok := n.piiPlaceholders[kind]; ok {
+		return placeholder
+	}
+	return options.DefaultPIIPlaceholders[kind]
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+// Names of the built-in normalization steps
+const (
+	StepProtect           = "protect"
+	StepEntities          = "entities"
+	StepSpecialYeh        = "special_yeh"
+	StepSpaces            = "spaces"
+	StepCharacters        = "characters"
+	StepDictionary        = "dictionary"
+	StepHalfSpaceFixer    = "half_space_fixer"
+	StepURLRemover        = "url_remover"
+	StepPunctuations      = "punctuations"
+	StepPIIMasker         = "pii_masker"
+	StepEndOfLineChar     = "end_of_line_char"
+	StepSpaceCombiner     = "space_combiner"
+	StepOuterSpaceRemover = "outer_space_remover"
+	StepIntToWord         = "int_to_word"
+	StepPhone             = "phone"
+	StepWordToInt         = "word_to_int"
+)
+
+// builtinStep is a step implemented by this package. It works on Text, so offsets survive it,
+// and it takes its settings from the Normalize it runs in.
+type builtinStep struct {
+	name string
+	run  func(n Normalize, text *Text)
+	conf *options.NormalizerOptions // the options of the step run on its own, the default ones when nil
+}
+
+func (s builtinStep) Name() string {
+	return s.name
+}
+
+// Apply runs the step on its own with its options
+func (s builtinStep) Apply(input string) string {
+	conf := options.DefaultOptions
+	if s.conf != nil {
+		conf = *s.conf
+	}
+	conf.Steps = []options.Step{s}
+	text := NewText(input)
+	s.run(*NewNormalizer(conf), text)
+	return text.String()
+}
+
+var builtinSteps = map[string]builtinStep{
+	StepProtect:           {name: StepProtect, run: Normalize.protectNormalizer},
+	StepEntities:          {name: StepEntities, run: Normalize.entityNormalizer},
+	StepSpecialYeh:        {name: StepSpecialYeh, run: Normalize.specialYehNormalizer},
+	StepSpaces:            {name: StepSpaces, run: Normalize.spaceNormalizer},
+	StepCharacters:        {name: StepCharacters, run: Normalize.characterNormalizer},
+	StepDictionary:        {name: StepDictionary, run: Normalize.dictionaryNormalizer},
+	StepHalfSpaceFixer:    {name: StepHalfSpaceFixer, run: Normalize.halfSpaceFixerNormalizer},
+	StepURLRemover:        {name: StepURLRemover, run: Normalize.urlNormalizer},
+	StepPunctuations:      {name: StepPunctuations, run: Normalize.punctuationNormalizer},
+	StepPIIMasker:         {name: StepPIIMasker, run: Normalize.piiNormalizer},
+	StepEndOfLineChar:     {name: StepEndOfLineChar, run: Normalize.endOfLineCharNormalizer},
+	StepSpaceCombiner:     {name: StepSpaceCombiner, run: Normalize.multiSpaceNormalizer},
+	StepOuterSpaceRemover: {name: StepOuterSpaceRemover, run: Normalize.outerSpaceNormalizer},
+	StepIntToWord:         {name: StepIntToWord, run: Normalize.intToWordNormalizer},
+	StepPhone:             {name: StepPhone, run: Normalize.phoneNormalizer},
+	StepWordToInt:         {name: StepWordToInt, run: Normalize.wordToIntNormalizer},
+}
+
+// BuiltinStep returns the built-in step with the given name
+func BuiltinStep(name string) options.Step {
+	step, ok := builtinSteps[name]
+	if !ok {
+		panic("seperno: unknown built-in step " + name)
+	}
+	return step
+}
+
+// BuiltinStepWithOptions returns the built-in step with the given name, which runs with conf when it is applied
+// on its own, so it finds the detector it needs there
+func BuiltinStepWithOptions(name string, conf options.NormalizerOptions) options.Step {
+	step := BuiltinStep(name).(builtinStep)
+	step.conf = &conf
+	return step
+}
+
+// DefaultSteps returns the pipeline described by the flags of conf, in the historical order
+func DefaultSteps(conf options.NormalizerOptions) []options.Step {
+	var steps []options.Step
+	if len(conf.ProtectedTerms) > 0 || len(conf.ProtectedPatterns) > 0 { // first, so the terms are seen as they were written
+		steps = append(steps, builtinSteps[StepProtect])
+	}
+	if len(conf.Entities) > 0 { // first, so the links are seen as they were written
+		steps = append(steps, builtinSteps[StepEntities])
+	}
+	steps = append(steps,
+		builtinSteps[StepSpecialYeh],
+		builtinSteps[StepSpaces],
+		builtinSteps[StepCharacters],
+	)
+	if conf.URLRemover {
+		steps = append(steps, builtinSteps[StepURLRemover])
+	}
+	if conf.PIIMasker { // before punctuations and spaces change the separators between the digits
+		steps = append(steps, builtinSteps[StepPIIMasker])
+	}
+	if hasPhrases(conf.Dictionaries) { // after the letters are unified, so the keys match every spelling
+		steps = append(steps, builtinSteps[StepDictionary])
+	}
+	if conf.HalfSpaceFixer { // after the letters are unified
+		steps = append(steps, builtinSteps[StepHalfSpaceFixer])
+	}
+	if conf.NormalizePunctuations {
+		steps = append(steps, builtinSteps[StepPunctuations])
+	}
+	if conf.EndsWithEndOfLineChar {
+		steps = append(steps, builtinSteps[StepEndOfLineChar])
+	}
+	if conf.SpaceCombiner {
+		steps = append(steps, builtinSteps[StepSpaceCombiner])
+	}
+	if conf.OuterSpaceRemover { // should be last normalization step
+		steps = append(steps, builtinSteps[StepOuterSpaceRemover])
+	}
+	if conf.Phone { // before the digits become words
+		steps = append(steps, builtinSteps[StepPhone])
+	}
+	if conf.IntToWord {
+		steps = append(steps, builtinSteps[StepIntToWord])
+	}
+	if conf.WordToInt {
+		steps = append(steps, builtinSteps[StepWordToInt])
+	}
+	return steps
+}
+
+// runSteps runs every step of the pipeline on text
+func (n Normalize) runSteps(text *Text) {
+	for _, step := range n.pipeline() {
+		if builtin, ok := step.(builtinStep); ok {
+			builtin.run(n, text)
+			continue
+		}
+		text.Replace(step.Apply(text.String()))
+	}
+}
+
+// builtinStepNames returns the names of the built-in steps the pipeline runs
+func (n Normalize) builtinStepNames() map[string]bool {
+	names := map[string]bool{}
+	for _, step := range n.pipeline() {
+		if builtin, ok := step.(builtinStep); ok {
+			names[builtin.name] = true
+		}
+	}
+	return names
+}
+
+// requireDetectors panics when the pipeline runs a step without the detector that step needs
+func (n Normalize) requireDetectors() {
+	steps := n.builtinStepNames()
+	if steps[StepWordToInt] && n.numberWordDetector == nil {
+		panic("seperno: the " + StepWordToInt + " step needs NormalizerOptions.NumberWordDetector")
+	}
+	if steps[StepPhone] && n.phoneDetector == nil {
+		panic("seperno: the " + StepPhone + " step needs NormalizerOptions.PhoneDetector")
+	}
+	if steps[StepPIIMasker] && n.piiDetector == nil {
+		panic("seperno: the " + StepPIIMasker + " step needs NormalizerOptions.PIIDetector")
+	}
+}
+
+// pipeline returns the steps the normalizer runs. NewNormalizer builds them once, a Normalize made
+// without it builds them from its flags.
+func (n Normalize) pipeline() []options.Step {
+	if n.steps != nil {
+		return n.steps
+	}
+	return DefaultSteps(options.NormalizerOptions{
+		URLRemover:            n.urlRemover,
+		OuterSpaceRemover:     n.outerSpaceRemover,
+		SpaceCombiner:         n.spaceCombiner,
+		NormalizePunctuations: n.normalizePunctuations,
+		EndsWithEndOfLineChar: n.endsWithEndOfLineChar,
+		PIIMasker:             n.piiMasker,
+		IntToWord:             n.intToWord,
+		Phone:                 n.phone,
+		WordToInt:             n.wordToInt,
+		HalfSpaceFixer:        n.halfSpaceFixer,
+		Entities:              n.entities,
+		Dictionaries:          n.dictionaries,
+		ProtectedTerms:        n.protectedTerms,
+		ProtectedPatterns:     n.protectedPatterns,
+	})
+}
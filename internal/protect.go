@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// protectNormalizer protects the protected terms and the matches of the protected patterns,
+// so the steps after it leave them as they were written
+func (n Normalize) protectNormalizer(text *Text) {
+	found := n.findProtected(text.runes)
+	edits := make([]edit, 0, len(found))
+	for _, r := range found {
+		edits = append(edits, edit{start: r[0], end: r[1], keep: true, protect: true})
+	}
+	text.applyEdits(edits, true)
+}
+
+// findProtected returns the rune ranges [start, end) of runes covered by a protected term or pattern,
+// sorted by position and merged where they overlap
+func (n Normalize) findProtected(runes []rune) [][2]int {
+	var found [][2]int
+	for _, term := range n.protectedTerms {
+		key := []rune(term)
+		if len(key) == 0 {
+			continue
+		}
+		for i := 0; i+len(key) <= len(runes); i++ {
+			if matchTerm(runes, i, key) {
+				found = append(found, [2]int{i, i + len(key)})
+			}
+		}
+	}
+	if len(n.protectedPatterns) > 0 {
+		s := string(runes)
+		for _, re := range n.protectedPatterns {
+			for _, m := range re.FindAllStringIndex(s, -1) {
+				if m[0] == m[1] {
+					continue
+				}
+				start := utf8.RuneCountInString(s[:m[0]])
+				found = append(found, [2]int{start, start + utf8.RuneCountInString(s[m[0]:m[1]])})
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i][0] < found[j][0]
+	})
+	merged := found[:1]
+	for _, r := range found[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] < last[1] {
+			last[1] = max(last[1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// matchTerm reports whether key is written at start of runes as whole words
+func matchTerm(runes []rune, start int, key []rune) bool {
+	for i, r := range key {
+		if runes[start+i] != r {
+			return false
+		}
+	}
+	if start > 0 && isPhraseWordRune(key[0]) && isPhraseWordRune(runes[start-1]) {
+		return false
+	}
+	end := start + len(key)
+	return end == len(runes) || !isPhraseWordRune(key[len(key)-1]) || !isPhraseWordRune(runes[end])
+}
+
+// endsTermPrefix reports whether runes end with the part of a protected term before one of its spaces,
+// so the term may go on after a space that follows runes
+func (n Normalize) endsTermPrefix(runes []rune) bool {
+	for _, term := range n.protectedTerms {
+		key := []rune(term)
+		for k := 1; k < len(key) && k <= len(runes); k++ {
+			if key[k] == ' ' && string(runes[len(runes)-k:]) == string(key[:k]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_protectNormalizer(t *testing.T) {
+	n := NewNormalizer(options.NormalizerOptions{
+		ProtectedTerms:        []string{"SNAPP20", "Snapp Food", "كد"},
+		ProtectedPatterns:     []*regexp.Regexp{regexp.MustCompile(`\d{2}[آ-ی]\d{3}`), regexp.MustCompile(`x*`)},
+		NormalizePunctuations: true,
+	})
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "term", input: "كد SNAPP20 را بزن", want: "كد SNAPP20 را بزن"},
+		{name: "term with a space", input: "سفارش از Snapp Food!", want: "سفارش از Snapp Food "},
+		{name: "whole words only", input: "SNAPP200 كدها", want: "snapp200 کدها"},
+		{name: "case-sensitive", input: "snapp20 SNAPP Food", want: "snapp20 snapp food"},
+		{name: "pattern", input: "پلاک 12ب345، ۱۲ب۳۴۵", want: "پلاک 12ب345  12ب345"},
+		{name: "pattern inside a word", input: "SNAPP20ب123", want: "snapp20ب123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := NewText(tt.input)
+			n.runSteps(text)
+			if got := text.String(); got != tt.want {
+				t.Errorf("BasicNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize_findProtected(t *testing.T) {
+	n := Normalize{
+		protectedTerms:    []string{"ab c", "ab"},
+		protectedPatterns: []*regexp.Regexp{regexp.MustCompile(`c d`)},
+	}
+	got := n.findProtected([]rune("ab c d ab"))
+	want := [][2]int{{0, 6}, {7, 9}}
+	if len(got) != len(want) {
+		t.Fatalf("findProtected() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("findProtected() = %v, want %v", got, want)
+		}
+	}
+}
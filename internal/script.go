@@ -0,0 +1,42 @@
+package internal
+
+import "github.com/snapp-incubator/seperno/pkg/options"
+
+// scriptLetters maps, for every script profile, the letters that belong to its language and their presentation
+// forms to the letter they stand for. The character normalizer keeps them instead of folding them into Persian
+// letters. The Persian profile has none.
+var scriptLetters = map[options.ScriptProfile]map[rune]rune{
+	options.ScriptSorani: letterForms(
+		[]rune("ڕ"), []rune("ڵ"), []rune("ێ"), []rune("ە"), []rune("ڤﭪﭫﭬﭭ"),
+		[]rune("ۆﯙﯚ"), []rune("ھﮪﮫﮬﮭ"),
+	),
+	options.ScriptPashto: letterForms(
+		[]rune("ټ"), []rune("ډ"), []rune("ړ"), []rune("ږ"), []rune("ښ"), []rune("ګ"), []rune("ڼ"), []rune("ځ"), []rune("څ"),
+		[]rune("ۍ"), []rune("ېﯤﯥﯦﯧ"), []rune("ئﺉﺊﺋﺌ"), []rune("يﻱﻲﻳﻴ"),
+	),
+	options.ScriptUrdu: letterForms(
+		[]rune("ٹﭦﭧﭨﭩ"), []rune("ڈﮈﮉ"), []rune("ڑﮌﮍ"), []rune("ںﮞﮟ"), []rune("ھﮪﮫﮬﮭ"),
+		[]rune("ہﮦﮧﮨﮩ"), []rune("ۂ"), []rune("ۃ"), []rune("ےﮮﮯ"), []rune("ۓﮰﮱ"),
+	),
+	// Dari is written with the Persian alphabet, and with the Pashto consonants in Afghan names such as "ډیورنډ"
+	options.ScriptDari: letterForms(
+		[]rune("ټ"), []rune("ډ"), []rune("ړ"), []rune("ږ"), []rune("ښ"), []rune("ګ"), []rune("ڼ"), []rune("ځ"), []rune("څ"),
+	),
+}
+
+// letterForms builds a letter map from groups whose first rune is the letter and the others are its forms
+func letterForms(groups ...[]rune) map[rune]rune {
+	letters := make(map[rune]rune)
+	for _, group := range groups {
+		for _, r := range group {
+			letters[r] = group[0]
+		}
+	}
+	return letters
+}
+
+// scriptLetter returns the letter r stands for and whether the script profile keeps it
+func (n Normalize) scriptLetter(r rune) (rune, bool) {
+	letter, ok := scriptLetters[options.ScriptProfile(n.script)][r]
+	return letter, ok
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_scriptProfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile options.ScriptProfile
+		input   string
+		want    string
+	}{
+		{name: "persian by default", input: "کوردستان ڕۆژ ٹیکسی ے", want: "کوردستان روژ تیکسی ی"},
+		{name: "persian", profile: options.ScriptPersian, input: "گەڵ", want: "گهل"},
+		{name: "sorani", profile: options.ScriptSorani, input: "ڕۆژی باش، گەڵ ﮬەڤاڵ ك", want: "ڕۆژی باش، گەڵ ھەڤاڵ ک"},
+		{name: "pashto", profile: options.ScriptPashto, input: "ښځې ډېر ټول ګډ يﻲ", want: "ښځې ډېر ټول ګډ يي"},
+		{name: "urdu", profile: options.ScriptUrdu, input: "ٹیکسی بڑا گھر ﮨے ﮮ", want: "ٹیکسی بڑا گھر ہے ے"},
+		{name: "urdu heh goal with hamza", profile: options.ScriptUrdu, input: "نقطۂ نظر", want: "نقطۂ نظر"},
+		{name: "dari", profile: options.ScriptDari, input: "ډیورنډ کابل ي ې", want: "ډیورنډ کابل ی ی"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Normalize{script: string(tt.profile)}
+			if got := n.BasicNormalizer(tt.input); got != tt.want {
+				t.Errorf("BasicNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
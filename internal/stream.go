@@ -0,0 +1,328 @@
+package internal
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// streamChunkSize is the number of bytes read from the source at once
+	streamChunkSize = 32 * 1024
+	// streamMaxSegment is the number of runes after which a segment is ended at its last white space,
+	// even where a step may see the text around it differently
+	streamMaxSegment = 64 * 1024
+)
+
+// streamLocalSteps are the built-in steps that look at runes and spaces but not at words
+var streamLocalSteps = map[string]bool{
+	StepSpecialYeh: true, StepSpaces: true, StepCharacters: true, StepPunctuations: true,
+	StepEndOfLineChar: true, StepSpaceCombiner: true, StepOuterSpaceRemover: true,
+}
+
+// streamReader normalizes its source segment by segment.
+//
+// A segment ends right before a plain space that follows a letter which stays a letter through
+// every step, or a digit when no step reads digits across spaces. No built-in step looks across such a point:
+// special yeh, half spaces and "&zwnj;" are local, URLs and digits stop at the space, and the space run starts
+// in the next segment. A new line is removed, so the words around it meet, and a segment ends at one only when
+// every step is local.
+// A segment does not end next to a word that a step removes or rewrites, such as a URL, since the words
+// and spaces around it would meet once it is gone. Trimming and the end-of-line character are applied only
+// at the real start and end of the document, so the output is the same as BasicNormalizer on the whole content.
+//
+// Numbers written with words, phone numbers, personal data and half spaces span spaces. With their steps,
+// the points are decided on the output of the whole pipeline on the pending text: a segment does not end where
+// an output rune comes from both sides of the point or where the space at the point is joined, nor next to
+// a word that may be part of a number, since the rest of the number may not have been read yet.
+//
+// Each point is looked at once, when two complete words follow it, and the pending text is normalized again
+// only once it has doubled. A stretch of streamMaxSegment runes without such a point is ended at its last
+// white space, so memory stays bounded.
+type streamReader struct {
+	n     Normalize
+	src   io.Reader
+	steps map[string]bool // the built-in steps the pipeline runs
+	local bool            // whether every built-in step of the pipeline is local
+	digit bool            // whether a segment may end after a digit
+
+	chunk   []byte
+	buf     []byte // bytes read from src that do not form a complete rune yet
+	pending []rune // runes not normalized yet
+	first   int    // rune index of pending[0] in the document
+	scanned int    // index of pending before which every point was looked at
+	next    int    // length of pending from which the whole of it is normalized again to decide a point
+	ends    [2]int // indices of pending of the last two spaces that end a word, -1 for none
+	started bool   // whether a segment was already normalized
+	out     bytes.Buffer
+	err     error
+}
+
+// BasicNormalizerReader returns a reader that yields the output of BasicNormalizer for the whole content of r.
+// User-defined steps run on segments of the content and must only make local changes. A stretch of text too long
+// to hold, with no point where a segment may end, is cut at its last white space and may differ there.
+func (n Normalize) BasicNormalizerReader(r io.Reader) io.Reader {
+	steps := n.builtinStepNames()
+	local := true
+	for name := range steps {
+		local = local && streamLocalSteps[name]
+	}
+	return &streamReader{
+		n: n, src: r, steps: steps, local: local,
+		digit: !steps[StepPhone] && !steps[StepPIIMasker], // phone numbers and card numbers go on over spaces
+		chunk: make([]byte, streamChunkSize), ends: [2]int{-1, -1},
+	}
+}
+
+// BasicNormalizerStream writes the output of BasicNormalizer for the whole content of src to dst.
+func (n Normalize) BasicNormalizerStream(dst io.Writer, src io.Reader) error {
+	_, err := io.Copy(dst, n.BasicNormalizerReader(src))
+	return err
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for s.out.Len() == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+		s.fill()
+	}
+	return s.out.Read(p)
+}
+
+// fill reads the next chunk from the source and normalizes every complete segment
+func (s *streamReader) fill() {
+	read, err := s.src.Read(s.chunk)
+	s.buf = append(s.buf, s.chunk[:read]...)
+
+	// decode complete runes only, the rest waits for the next chunk unless the source is done
+	for len(s.buf) > 0 && (err != nil || utf8.FullRune(s.buf)) {
+		r, size := utf8.DecodeRune(s.buf)
+		if i := len(s.pending); i > 0 && unicode.IsSpace(r) && !unicode.IsSpace(s.pending[i-1]) {
+			s.ends = [2]int{s.ends[1], i}
+		}
+		s.pending = append(s.pending, r)
+		s.buf = s.buf[size:]
+	}
+
+	if err != nil {
+		if err == io.EOF {
+			s.flush(len(s.pending), true)
+		}
+		s.err = err
+		return
+	}
+
+	if cut := s.lastCut(); cut > 0 {
+		s.flush(cut, false)
+	}
+	if len(s.pending) >= streamMaxSegment {
+		s.flush(s.lastSpace(), false)
+	}
+}
+
+// lastCut returns the last index of pending where a segment can end, or zero if there is none.
+// Only the points after the ones looked at by the previous calls are looked at.
+func (s *streamReader) lastCut() int {
+	limit := s.ends[0] // the points before it are followed by two complete words
+	if limit <= s.scanned || len(s.pending) < s.next {
+		return 0
+	}
+
+	// Numbers written with words, phone numbers, personal data and half spaces span the space between two words
+	words := s.steps[StepWordToInt] || s.steps[StepPhone] || s.steps[StepPIIMasker] || s.steps[StepHalfSpaceFixer]
+	numbers := s.steps[StepWordToInt] || s.steps[StepPhone] || s.steps[StepPIIMasker]
+	dictionary := s.n.phrases != nil && s.steps[StepDictionary]
+	urls := s.steps[StepURLRemover]
+	entities := s.steps[StepEntities] && len(s.n.entities) > 0
+	var blocked []bool
+	var protected [][2]int
+	protect := s.steps[StepProtect]
+
+	cut, analyzed := 0, false
+	for i := max(s.scanned, 1); i < limit; i++ {
+		if !s.mayEndAt(i) {
+			continue
+		}
+		// the whole of pending is only looked at when there is a point to decide
+		if !analyzed {
+			if words {
+				blocked = s.blockedPoints()
+			}
+			if protect {
+				protected = s.n.findProtected(s.pending)
+			}
+			analyzed = true
+		}
+		// Phrases of the dictionaries and protected terms span spaces, so with them a segment does not end inside one.
+		if (words && blocked[i]) || (dictionary && s.mayGoOnAt(i)) || (protect && s.protectedAt(i, protected)) ||
+			((urls || entities || dictionary) && s.rewrittenAt(i, urls, entities, dictionary)) {
+			continue
+		}
+		// A number may go on over the next space, or a prefix of it may be read as a shorter number,
+		// so a segment does not end next to a word that may be part of one
+		if numbers && s.besideNumberAt(i) {
+			continue
+		}
+		cut = i
+	}
+	s.scanned = limit
+	if analyzed {
+		s.next = 2 * len(s.pending) // pending is normalized again once it has doubled, so the work stays linear
+	}
+	return cut
+}
+
+// blockedPoints normalizes pending the way the next segment would be normalized and reports, for each index,
+// whether a step looked across the point right before it: an output rune comes from runes on both sides of it,
+// as the digits of a number written with words or a masked card number do, or the white space after it
+// did not stay a white space, as a space the half space fixer joins does not.
+func (s *streamReader) blockedPoints() []bool {
+	n := s.n
+	n.piiReport, n.entityReport = nil, nil // reported when the segment is flushed
+	text := newSegment(append([]rune(nil), s.pending...), 0, !s.started, false)
+	n.runSteps(text)
+
+	across := make([]int, len(s.pending)+1)
+	joined := make([]bool, len(s.pending)+1)
+	for k, span := range text.spans {
+		if span.End-span.Start > 1 {
+			across[span.Start+1]++
+			across[span.End]--
+		}
+		if unicode.IsSpace(s.pending[span.Start]) && !unicode.IsSpace(text.runes[k]) {
+			joined[span.Start] = true
+		}
+	}
+	for i := len(s.pending) - 2; i >= 0; i-- { // a run of white space is joined when one of its runes is
+		joined[i] = joined[i] || (unicode.IsSpace(s.pending[i]) && unicode.IsSpace(s.pending[i+1]) && joined[i+1])
+	}
+	blocked := make([]bool, len(s.pending)+1)
+	for i, depth := 0, 0; i < len(s.pending); i++ {
+		depth += across[i]
+		blocked[i] = depth > 0 || joined[i]
+	}
+	return blocked
+}
+
+// mayEndAt reports whether the runes around index i let a segment end right before it
+func (s *streamReader) mayEndAt(i int) bool {
+	switch s.pending[i] {
+	case ' ':
+		return s.isStable(s.pending[i-1])
+	case '\n':
+		// the words around a new line meet, unless it is followed by a white space that keeps them apart
+		return s.isStable(s.pending[i-1]) &&
+			(s.local || (i+1 < len(s.pending) && s.pending[i+1] != '\n' && unicode.IsSpace(s.pending[i+1])))
+	}
+	return false
+}
+
+// lastSpace returns the index of the last white space in the second half of pending,
+// or the length of pending if there is none
+func (s *streamReader) lastSpace() int {
+	for i := len(s.pending) - 1; i > len(s.pending)/2; i-- {
+		if unicode.IsSpace(s.pending[i]) {
+			return i
+		}
+	}
+	return len(s.pending)
+}
+
+// mayGoOnAt reports whether a phrase of the dictionaries may go on over the space at index i
+func (s *streamReader) mayGoOnAt(i int) bool {
+	start := i
+	for start > 0 && !unicode.IsSpace(s.pending[start-1]) {
+		start--
+	}
+	return s.n.phrases.endsInnerWord(s.n.normalizeWords(string(s.pending[start:i])))
+}
+
+// protectedAt reports whether the space at index i is inside a protected match, or a protected term may go on over it
+func (s *streamReader) protectedAt(i int, protected [][2]int) bool {
+	for _, r := range protected {
+		if r[0] < i && i < r[1] {
+			return true
+		}
+	}
+	return s.n.endsTermPrefix(s.pending[:i])
+}
+
+// rewrittenAt reports whether the URL remover, the entity handler or the dictionaries, for those that run,
+// may change the word before or after the space at index i
+func (s *streamReader) rewrittenAt(i int, urls, entities, dictionary bool) bool {
+	for _, word := range s.wordsAround(i) {
+		if urls && urlRemovalRegex.MatchString(strings.ToLower(word)) {
+			return true
+		}
+		if entities && len(findEntities(word)) > 0 {
+			return true
+		}
+		if dictionary && s.n.phrases.touches(s.n.normalizeWords(word)) {
+			return true
+		}
+	}
+	return false
+}
+
+// besideNumberAt reports whether the word before or after the space at index i may be part of a number:
+// it has a digit, it has no letter and may be removed, as "." or "-" between "منفی" and a number,
+// or it is a number written with words
+func (s *streamReader) besideNumberAt(i int) bool {
+	for _, word := range s.wordsAround(i) {
+		word = s.n.normalizeWords(word)
+		if strings.ContainsFunc(word, unicode.IsDigit) || !strings.ContainsFunc(word, unicode.IsLetter) ||
+			(s.n.numberWordDetector != nil && len(s.n.numberWordDetector(word)) > 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordsAround returns the words before and after the space at index i.
+// New lines are removed by the characters step, so they do not end a word.
+func (s *streamReader) wordsAround(i int) [2]string {
+	separator := func(r rune) bool { return unicode.IsSpace(r) && r != '\n' }
+	start := i
+	for start > 0 && !separator(s.pending[start-1]) {
+		start--
+	}
+	first := i + 1
+	for first < len(s.pending) && unicode.IsSpace(s.pending[first]) { // new lines alone are no word
+		first++
+	}
+	end := first
+	for end < len(s.pending) && !separator(s.pending[end]) {
+		end++
+	}
+	return [2]string{string(s.pending[start:i]), string(s.pending[first:end])}
+}
+
+// isStable reports whether r stays a letter, or a digit when a segment may end after one, through every step,
+// so nothing before it can affect what comes after it
+func (s *streamReader) isStable(r rune) bool {
+	if !unicode.IsLetter(r) && !unicode.IsDigit(r) || specialYeh(r) != nil {
+		return false
+	}
+	c := s.n.NormalizeCharacters(string(r))[0]
+	return unicode.IsLetter(c) || (s.digit && unicode.IsDigit(c))
+}
+
+// flush normalizes pending[:end] as one segment
+func (s *streamReader) flush(end int, atEnd bool) {
+	if end == 0 {
+		return
+	}
+	text := newSegment(append([]rune(nil), s.pending[:end]...), s.first, !s.started, atEnd)
+	s.n.runSteps(text)
+	s.out.WriteString(text.String())
+
+	s.started = true
+	s.first += end
+	s.scanned = max(s.scanned-end, 0)
+	s.ends = [2]int{s.ends[0] - end, s.ends[1] - end}
+	s.pending = append(s.pending[:0], s.pending[end:]...)
+	s.next = 2 * len(s.pending)
+}
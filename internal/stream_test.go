@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestNormalize_BasicNormalizerReader(t *testing.T) {
+	inputs := []string{
+		"",
+		"   ",
+		"سلام دنیا",
+		"  تست   https://example.com/مسیر?q=1   تست  ",
+		"کتاب‌ها&zwnj;ی من\nو  تو ے ﻩ کوچه 110 پلاک ۲۰.",
+		"سلام,خوبی؟ چه خبرا .  ",
+		"a b c d e f g h i j k l m n o p q r s t u v w x y z 1 2 3 4 5",
+		strings.Repeat("خیابان بیست و پنج 25 ", 3000) + " .",
+		"سلام @Ali و www.Snapp.ir/Ride، info@snapp.ir #تست_یک",
+		strings.Repeat("ما می خواهیم کتاب ها را بخوانیم و ﻻ خانه ام بزرگ تر است ", 300),
+		strings.Repeat("سفارش اسنپ   فود از خ. ولیعصر تا اسنپ ", 300),
+		strings.Repeat("سلام https://snapp.ir دنیا www.snapp.ir سلام @ali کم www.snapp.ir ها ", 30),
+		strings.Repeat("کد SNAPP20 برای Snapp Food Ltd و پلاک 12 ب 345 ", 300),
+		strings.Repeat("خ.ﻻ \n https://snapp.ir ها  سلام\n 123\n\na ب 45 کتاب\nها ", 100),
+	}
+	normalizers := []Normalize{
+		{},
+		{convertHalfSpaceToSpace: true, spaceCombiner: true},
+		{urlRemover: true, outerSpaceRemover: true, normalizePunctuations: true},
+		{endsWithEndOfLineChar: true, intToWord: true, convertNumberLang: "fa"},
+		{entities: map[options.EntityKind]options.EntityMode{
+			options.EntityURL: options.EntityExtract, options.EntityEmail: options.EntityReplace, options.EntityHashtag: options.EntityRemove,
+		}, normalizePunctuations: true, spaceCombiner: true},
+		{halfSpaceFixer: true, spaceCombiner: true},
+		{urlRemover: true, spaceCombiner: true},
+		{entities: map[options.EntityKind]options.EntityMode{
+			options.EntityURL: options.EntityRemove, options.EntityMention: options.EntityRemove,
+		}, halfSpaceFixer: true, outerSpaceRemover: true},
+		{urlRemover: true, normalizePunctuations: true, endsWithEndOfLineChar: true, spaceCombiner: true, outerSpaceRemover: true, intToWord: true},
+		*NewNormalizer(options.NormalizerOptions{
+			Dictionaries:   []options.Dictionary{{Phrases: map[string]string{"اسنپ فود": "اسنپ‌فود", "خ.": "خیابان"}}},
+			HalfSpaceFixer: true, NormalizePunctuations: true, SpaceCombiner: true,
+		}),
+		*NewNormalizer(options.NormalizerOptions{
+			ProtectedTerms:        []string{"SNAPP20", "Snapp Food Ltd"},
+			ProtectedPatterns:     []*regexp.Regexp{regexp.MustCompile(`\d{2} [آ-ی] \d{3}`)},
+			NormalizePunctuations: true, SpaceCombiner: true,
+		}),
+	}
+	readers := map[string]func(r io.Reader) io.Reader{
+		"whole":    func(r io.Reader) io.Reader { return r },
+		"one_byte": iotest.OneByteReader,
+		"half":     iotest.HalfReader,
+	}
+	for name, wrap := range readers {
+		for _, n := range normalizers {
+			for _, input := range inputs {
+				got, err := io.ReadAll(n.BasicNormalizerReader(wrap(strings.NewReader(input))))
+				if err != nil {
+					t.Fatalf("%s: BasicNormalizerReader() error = %v", name, err)
+				}
+				if want := n.BasicNormalizer(input); string(got) != want {
+					t.Errorf("%s: BasicNormalizerReader(%.40q) = %.80q, want %.80q", name, input, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestNormalize_BasicNormalizerStream(t *testing.T) {
+	n := Normalize{spaceCombiner: true, outerSpaceRemover: true}
+	var out bytes.Buffer
+	if err := n.BasicNormalizerStream(&out, strings.NewReader("  سلام    دنیا  ")); err != nil {
+		t.Fatalf("BasicNormalizerStream() error = %v", err)
+	}
+	if got, want := out.String(), "سلام دنیا"; got != want {
+		t.Errorf("BasicNormalizerStream() = %v, want %v", got, want)
+	}
+}
+
+func TestNormalize_BasicNormalizerReaderLongStretches(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     Normalize
+		input string
+		cuts  bool // whether the input has points where a segment ends before it grows long
+	}{
+		{name: "lines", input: strings.Repeat("سلام\n", 1<<16), cuts: true},
+		{name: "digits", input: strings.Repeat("123 ", 1<<17), cuts: true},
+		{name: "lines with the half space fixer", n: Normalize{halfSpaceFixer: true}, input: strings.Repeat("سلام\n", 1<<16)},
+		{name: "digits with the half space fixer", n: Normalize{halfSpaceFixer: true}, input: strings.Repeat("123 ", 1<<17), cuts: true},
+		{name: "one long word", input: strings.Repeat("ب", 3*streamMaxSegment)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := tt.n.BasicNormalizerReader(strings.NewReader(tt.input)).(*streamReader)
+			var out bytes.Buffer
+			buf := make([]byte, 4096)
+			longest := 0
+			for {
+				k, err := reader.Read(buf)
+				out.Write(buf[:k])
+				longest = max(longest, len(reader.pending))
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Read() error = %v", err)
+				}
+			}
+			if want := tt.n.BasicNormalizer(tt.input); out.String() != want {
+				t.Errorf("BasicNormalizerReader() = %.80q, want %.80q", out.String(), want)
+			}
+			if limit := streamMaxSegment + streamChunkSize; longest > limit {
+				t.Errorf("%d runes were pending, want at most %d", longest, limit)
+			}
+			if limit := 2 * streamChunkSize; tt.cuts && longest > limit {
+				t.Errorf("%d runes were pending, want at most %d", longest, limit)
+			}
+		})
+	}
+}
+
+func TestNormalize_BasicNormalizerReaderError(t *testing.T) {
+	n := Normalize{}
+	_, err := io.ReadAll(n.BasicNormalizerReader(iotest.ErrReader(io.ErrUnexpectedEOF)))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("BasicNormalizerReader() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -0,0 +1,326 @@
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/snapp-incubator/seperno/pkg/offset"
+)
+
+// Text is a rune buffer that remembers, for every rune, which span of the original input it came from.
+// All normalization steps work on Text so the offsets survive every transformation.
+type Text struct {
+	runes []rune
+	spans []offset.Span
+	// protected marks the runes the built-in steps must leave as they are, it is nil when there are none
+	protected []bool
+
+	// atStart and atEnd tell whether the content touches the start and the end of the document.
+	// They are false for the inner edges of the segments of a stream.
+	atStart bool
+	atEnd   bool
+}
+
+// NewText creates a Text where each rune maps to its own position in input.
+func NewText(input string) *Text {
+	return newSegment([]rune(input), 0, true, true)
+}
+
+// newSegment creates a Text for a part of a document that starts at rune index first.
+func newSegment(runes []rune, first int, atStart, atEnd bool) *Text {
+	spans := make([]offset.Span, len(runes))
+	for i := range runes {
+		spans[i] = offset.Span{Start: first + i, End: first + i + 1}
+	}
+	return &Text{runes: runes, spans: spans, atStart: atStart, atEnd: atEnd}
+}
+
+func (t *Text) String() string {
+	return string(t.runes)
+}
+
+// Offsets returns the offset map of the current content.
+func (t *Text) Offsets() offset.Map {
+	spans := make([]offset.Span, len(t.spans))
+	copy(spans, t.spans)
+	return offset.NewMap(spans)
+}
+
+// Map replaces every rune but the protected ones with f(rune), keeping the offsets untouched.
+func (t *Text) Map(f func(r rune) rune) {
+	for i, r := range t.runes {
+		if !t.isProtected(i) {
+			t.runes[i] = f(r)
+		}
+	}
+}
+
+// Filter removes every rune but the protected ones for which keep returns false.
+func (t *Text) Filter(keep func(r rune) bool) {
+	j := 0
+	for i, r := range t.runes {
+		if t.isProtected(i) || keep(r) {
+			t.runes[j] = r
+			t.spans[j] = t.spans[i]
+			if t.protected != nil {
+				t.protected[j] = t.protected[i]
+			}
+			j++
+		}
+	}
+	t.runes = t.runes[:j]
+	t.spans = t.spans[:j]
+	if t.protected != nil {
+		t.protected = t.protected[:j]
+	}
+}
+
+// Expand replaces every rune but the protected ones with the runes returned by f, all of them pointing at
+// the original rune's span. When f returns nil the rune is kept as it is.
+func (t *Text) Expand(f func(r rune) []rune) {
+	edits := make([]edit, 0)
+	for i, r := range t.runes {
+		if t.isProtected(i) {
+			continue
+		}
+		if replacement := f(r); replacement != nil {
+			edits = append(edits, edit{start: i, end: i + 1, replacement: string(replacement)})
+		}
+	}
+	if len(edits) > 0 {
+		t.applyEdits(edits, false)
+	}
+}
+
+// TrimSpace removes leading and trailing white space as defined by unicode.IsSpace, like strings.TrimSpace.
+func (t *Text) TrimSpace() {
+	t.TrimFunc(unicode.IsSpace)
+}
+
+// TrimFunc removes leading and trailing runes satisfying f.
+// Only the edges that touch the start or the end of the document are trimmed.
+func (t *Text) TrimFunc(f func(r rune) bool) {
+	start, end := 0, len(t.runes)
+	for t.atStart && start < end && !t.isProtected(start) && f(t.runes[start]) {
+		start++
+	}
+	for t.atEnd && end > start && !t.isProtected(end-1) && f(t.runes[end-1]) {
+		end--
+	}
+	t.slice(start, end)
+}
+
+// DropLast removes the last rune of the document if there is one and it is not protected.
+func (t *Text) DropLast() {
+	if len(t.runes) == 0 || !t.atEnd || t.isProtected(len(t.runes)-1) {
+		return
+	}
+	t.slice(0, len(t.runes)-1)
+}
+
+// slice keeps the runes [start, end)
+func (t *Text) slice(start, end int) {
+	t.runes = t.runes[start:end]
+	t.spans = t.spans[start:end]
+	if t.protected != nil {
+		t.protected = t.protected[start:end]
+	}
+}
+
+// Last returns the last rune of the document and whether there is one in this Text.
+func (t *Text) Last() (rune, bool) {
+	if len(t.runes) == 0 || !t.atEnd {
+		return 0, false
+	}
+	return t.runes[len(t.runes)-1], true
+}
+
+// Replace sets the content to replacement. Offsets are kept for the common prefix and suffix
+// of the old and new content, and the changed middle points at the union of the spans it replaced.
+func (t *Text) Replace(replacement string) {
+	s := t.String()
+	if s == replacement {
+		return
+	}
+
+	prefix := 0
+	for prefix < len(s) && prefix < len(replacement) {
+		r1, size1 := utf8.DecodeRuneInString(s[prefix:])
+		r2, size2 := utf8.DecodeRuneInString(replacement[prefix:])
+		if r1 != r2 || size1 != size2 {
+			break
+		}
+		prefix += size1
+	}
+	suffix := 0
+	for suffix < len(s)-prefix && suffix < len(replacement)-prefix {
+		r1, size1 := utf8.DecodeLastRuneInString(s[:len(s)-suffix])
+		r2, size2 := utf8.DecodeLastRuneInString(replacement[:len(replacement)-suffix])
+		if r1 != r2 || size1 != size2 {
+			break
+		}
+		suffix += size1
+	}
+
+	// user-defined steps may change protected runes
+	start := utf8.RuneCountInString(s[:prefix])
+	end := start + utf8.RuneCountInString(s[prefix:len(s)-suffix])
+	t.applyEdits([]edit{{start: start, end: end, replacement: replacement[prefix : len(replacement)-suffix]}}, true)
+}
+
+// ReplaceString replaces every non-overlapping occurrence of old with replacement.
+func (t *Text) ReplaceString(old, replacement string) {
+	if old == "" {
+		return
+	}
+	s := t.String()
+	var matches [][]int
+	for pos := 0; ; {
+		idx := strings.Index(s[pos:], old)
+		if idx < 0 {
+			break
+		}
+		matches = append(matches, []int{pos + idx, pos + idx + len(old)})
+		pos += idx + len(old)
+	}
+	t.replaceMatches(s, matches, func(string) string {
+		return replacement
+	})
+}
+
+// ReplaceRegexp replaces every match of re with repl(match).
+// Every rune of a replacement points at the union of the spans of the runes it replaced.
+func (t *Text) ReplaceRegexp(re *regexp.Regexp, repl func(match string) string) {
+	s := t.String()
+	t.replaceMatches(s, re.FindAllStringIndex(s, -1), repl)
+}
+
+// replaceMatches replaces the byte ranges of s (the current content) listed in matches.
+// Matches that touch protected runes are left alone.
+func (t *Text) replaceMatches(s string, matches [][]int, repl func(match string) string) {
+	if len(matches) == 0 {
+		return
+	}
+
+	// byte and rune cursors walk s and t.runes side by side
+	bytePos, runePos := 0, 0
+	advance := func(to int) int {
+		for bytePos < to {
+			_, size := utf8.DecodeRuneInString(s[bytePos:])
+			bytePos += size
+			runePos++
+		}
+		return runePos
+	}
+
+	edits := make([]edit, 0, len(matches))
+	for _, m := range matches {
+		start := advance(m[0])
+		edits = append(edits, edit{start: start, end: advance(m[1]), replacement: repl(s[m[0]:m[1]])})
+	}
+	t.applyEdits(edits, false)
+}
+
+// replaceRuneRanges replaces the rune ranges [start, end) listed in ranges, the i-th one with repl(i).
+// Ranges that touch protected runes are left alone.
+func (t *Text) replaceRuneRanges(ranges [][2]int, repl func(i int) string) {
+	edits := make([]edit, 0, len(ranges))
+	for i, r := range ranges {
+		edits = append(edits, edit{start: r[0], end: r[1], replacement: repl(i)})
+	}
+	t.applyEdits(edits, false)
+}
+
+// Protect keeps the runes [start, end) out of reach of the steps that run after it.
+// Built-in steps leave protected runes as they are, user-defined steps may still change them.
+func (t *Text) Protect(start, end int) {
+	t.applyEdits([]edit{{start: start, end: end, keep: true, protect: true}}, true)
+}
+
+// edit replaces the runes [start, end) of a Text
+type edit struct {
+	start, end  int
+	replacement string
+	keep        bool // keep the runes instead of replacing them
+	protect     bool // protect the runes the edit leaves
+}
+
+// applyEdits applies edits sorted by position that do not overlap.
+// Every rune of a replacement points at the union of the spans of the runes it replaced.
+// Unless force is set, edits that touch protected runes are skipped.
+func (t *Text) applyEdits(edits []edit, force bool) {
+	runes := make([]rune, 0, len(t.runes))
+	spans := make([]offset.Span, 0, len(t.spans))
+	var protected []bool
+	if t.protected != nil {
+		protected = make([]bool, 0, len(t.runes))
+	}
+	appendRune := func(r rune, span offset.Span, protect bool) {
+		if protect && protected == nil {
+			protected = make([]bool, len(runes), cap(runes))
+		}
+		runes = append(runes, r)
+		spans = append(spans, span)
+		if protected != nil {
+			protected = append(protected, protect)
+		}
+	}
+	keepRange := func(start, end int, protect bool) {
+		for i := start; i < end; i++ {
+			appendRune(t.runes[i], t.spans[i], protect || t.isProtected(i))
+		}
+	}
+
+	pos := 0
+	for _, e := range edits {
+		if !force && t.anyProtected(e.start, e.end) {
+			continue
+		}
+		keepRange(pos, e.start, false)
+		if e.keep {
+			keepRange(e.start, e.end, e.protect)
+		} else {
+			span := t.rangeSpan(e.start, e.end)
+			for _, r := range e.replacement {
+				appendRune(r, span, e.protect)
+			}
+		}
+		pos = e.end
+	}
+	keepRange(pos, len(t.runes), false)
+
+	t.runes = runes
+	t.spans = spans
+	t.protected = protected
+}
+
+// setRunes replaces the content with runes of the same length, keeping the protected runes
+func (t *Text) setRunes(runes []rune) {
+	for i := range runes {
+		if t.isProtected(i) {
+			runes[i] = t.runes[i]
+		}
+	}
+	t.runes = runes
+}
+
+func (t *Text) isProtected(i int) bool {
+	return t.protected != nil && t.protected[i]
+}
+
+// anyProtected reports whether one of the runes [start, end) is protected
+func (t *Text) anyProtected(start, end int) bool {
+	for i := start; i < end && t.protected != nil; i++ {
+		if t.protected[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeSpan returns the union of spans of runes [start, end), or an empty span at start for an empty range.
+func (t *Text) rangeSpan(start, end int) offset.Span {
+	return offset.NewMap(t.spans).Range(start, end)
+}
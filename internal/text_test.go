@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/pkg/offset"
+)
+
+func TestText_Replace(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		replacement string
+		want        []offset.Span
+	}{
+		{
+			name:        "unchanged",
+			input:       "سلام",
+			replacement: "سلام",
+			want:        []offset.Span{{Start: 0, End: 1}, {Start: 1, End: 2}, {Start: 2, End: 3}, {Start: 3, End: 4}},
+		},
+		{
+			name:        "changed middle",
+			input:       "a-b-c",
+			replacement: "a__c",
+			want:        []offset.Span{{Start: 0, End: 1}, {Start: 1, End: 4}, {Start: 1, End: 4}, {Start: 4, End: 5}},
+		},
+		{
+			name:        "insertion",
+			input:       "ab",
+			replacement: "a b",
+			want:        []offset.Span{{Start: 0, End: 1}, {Start: 1, End: 1}, {Start: 1, End: 2}},
+		},
+		{
+			name:        "deletion",
+			input:       "a  b",
+			replacement: "ab",
+			want:        []offset.Span{{Start: 0, End: 1}, {Start: 3, End: 4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := NewText(tt.input)
+			text.Replace(tt.replacement)
+			if got := text.String(); got != tt.replacement {
+				t.Fatalf("Replace() = %v, want %v", got, tt.replacement)
+			}
+			offsets := text.Offsets()
+			if offsets.Len() != len(tt.want) {
+				t.Fatalf("offsets cover %d runes, want %d", offsets.Len(), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got := offsets.Span(i); got != want {
+					t.Errorf("Span(%d) = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestText_Protect(t *testing.T) {
+	text := NewText(" AB-CD ")
+	text.Protect(1, 3)
+	text.Map(unicode.ToLower)
+	text.ReplaceString("B-C", "")
+	text.Filter(func(r rune) bool { return r != '-' })
+	text.TrimSpace()
+	if got, want := text.String(), "ABcd"; got != want {
+		t.Errorf("built-in changes = %v, want %v", got, want)
+	}
+
+	text.Replace("abcd")
+	if got, want := text.String(), "abcd"; got != want {
+		t.Errorf("Replace() = %v, want %v", got, want)
+	}
+}
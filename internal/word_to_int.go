@@ -0,0 +1,18 @@
+package internal
+
+// wordToIntNormalizer replaces numbers written with words by digits in the configured language
+func (n Normalize) wordToIntNormalizer(text *Text) {
+	s := text.String()
+	numbers := n.numberWordDetector(s)
+	if len(numbers) == 0 {
+		return
+	}
+
+	ranges := make([][2]int, 0, len(numbers))
+	for _, number := range numbers {
+		ranges = append(ranges, [2]int{number.Start, number.End + 1})
+	}
+	text.replaceRuneRanges(ranges, func(i int) string {
+		return n.convertDigits(numbers[i].Digits)
+	})
+}
@@ -5,3 +5,25 @@ import "github.com/snapp-incubator/seperno/pkg/lfd"
 func NewPersianNumberDetector() lfd.NumberDetector {
 	return &lfd.PersianNumberDetector{}
 }
+
+// NewColloquialPersianNumberDetector returns a detector that also understands informal spoken forms
+// such as "یه", "دوتا" or "هیژده"
+func NewColloquialPersianNumberDetector() lfd.NumberDetector {
+	return &lfd.PersianNumberDetector{Colloquial: true}
+}
+
+// NewPersianDateDetector returns a detector of Jalali dates such as "۱۵ مهر ۱۴۰۲" or "۱۴۰۲/۰۷/۱۵"
+func NewPersianDateDetector() lfd.DateDetector {
+	return &lfd.PersianDateDetector{}
+}
+
+// NewPersianTimeDetector returns a detector of times of day such as "ساعت پنج و نیم عصر"
+// and durations such as "دو ساعت و بیست دقیقه"
+func NewPersianTimeDetector() lfd.TimeDetector {
+	return &lfd.PersianTimeDetector{}
+}
+
+// NewPersianPhoneDetector returns a detector of Iranian phone numbers such as "۰۹۱۲ ۳۴۵ ۶۷۸۹" or "+98 21 8877 6655"
+func NewPersianPhoneDetector() lfd.PhoneDetector {
+	return &lfd.PersianPhoneDetector{}
+}
@@ -2,8 +2,12 @@ package seperno
 
 import (
 	"C"
+	"io"
+	"regexp"
 
 	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/lfd"
+	"github.com/snapp-incubator/seperno/pkg/offset"
 	"github.com/snapp-incubator/seperno/pkg/options"
 )
 
@@ -12,7 +16,21 @@ func NewNormalize(ops ...options.Options) Normalize {
 	for _, config := range ops {
 		config.Apply(&opts)
 	}
-	return internal.NewNormalizer(opts)
+	return internal.NewNormalizer(withDetectors(opts))
+}
+
+// withDetectors sets the detectors of the lfd package that opts does not have
+func withDetectors(opts options.NormalizerOptions) options.NormalizerOptions {
+	if opts.NumberWordDetector == nil {
+		opts.NumberWordDetector = lfd.DetectNumberWords
+	}
+	if opts.PhoneDetector == nil {
+		opts.PhoneDetector = lfd.DetectPhoneNumbers
+	}
+	if opts.PIIDetector == nil {
+		opts.PIIDetector = lfd.DetectPII
+	}
+	return opts
 }
 
 func WithConvertHalfSpaceToSpace() options.Options {
@@ -21,6 +39,69 @@ func WithConvertHalfSpaceToSpace() options.Options {
 	})
 }
 
+// WithCaseMode sets what happens to the case of letters. The normalizer lowercases them unless it is set to
+// options.CasePreserve, which keeps brand names and codes such as "SNAPP20" as they are, or options.CaseFold,
+// which applies the simple Unicode case folding and expands "ß", "ŉ", "İ" and the Latin ligatures such as "ﬁ".
+func WithCaseMode(mode options.CaseMode) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Case = mode
+	})
+}
+
+// WithDiacritics sets what happens to diacritics (اعراب). They are removed unless it is set to
+// options.DiacriticsKeep, or to options.DiacriticsKeepTashdidTanvin which keeps only tashdid and tanvin.
+// Letters are unified whatever the policy.
+func WithDiacritics(policy options.DiacriticsPolicy) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Diacritics = policy
+	})
+}
+
+// WithScriptProfile keeps the letters of the given language, such as "ڕ" and "ڵ" for Kurdish (Sorani)
+// or "ٹ" and "ے" for Urdu, instead of folding them into Persian letters.
+// Presentation forms and true variants are still unified.
+func WithScriptProfile(profile options.ScriptProfile) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Script = profile
+	})
+}
+
+// WithDictionary adds user-defined replacements: rune mappings that take precedence over the built-in ones,
+// and words or phrases replaced as whole words after the letters are unified, as in "خ." to "خیابان".
+// Use options.LoadDictionary to read one from a file. A later dictionary wins over an earlier one.
+func WithDictionary(dict options.Dictionary) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Dictionaries = append(append([]options.Dictionary{}, option.Dictionaries...), dict)
+	})
+}
+
+// WithProtectedTerms leaves the given terms as they are written, such as promo codes, SKUs or brand names:
+// "SNAPP20" is neither lowercased nor folded while the text around it is normalized.
+// A term matches whole words of the input and is case-sensitive.
+func WithProtectedTerms(terms ...string) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.ProtectedTerms = append(append([]string{}, option.ProtectedTerms...), terms...)
+	})
+}
+
+// WithProtectedPatterns leaves the matches of the given patterns in the input as they are written,
+// such as license plates or links to keep. Use WithProtectedTerms for fixed texts.
+func WithProtectedPatterns(patterns ...*regexp.Regexp) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.ProtectedPatterns = append(append([]*regexp.Regexp{}, option.ProtectedPatterns...), patterns...)
+	})
+}
+
+// WithHalfSpaceFixer writes a half space (ZWNJ) where one belongs but a space was typed: after the verb prefixes
+// "می" and "نمی", before the plural and comparative suffixes "ها", "های", "تر" and "ترین", and before pronoun
+// suffixes such as "ام" or "شان" after a word that ends with "ه". "می خواهم" becomes "می‌خواهم". "تر" is joined only
+// after common adjectives, since it also means wet, and no comparative suffix is joined to a pronoun.
+func WithHalfSpaceFixer() options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.HalfSpaceFixer = true
+	})
+}
+
 func WithSpaceCombiner() options.Options {
 	return options.NewFuncOption(func(option *options.NormalizerOptions) {
 		option.SpaceCombiner = true
@@ -39,6 +120,68 @@ func WithURLRemover() options.Options {
 	})
 }
 
+// WithEntityHandler sets what happens to the given kind of entity: EntityRemove removes it, EntityReplace
+// writes a token such as "[URL]" instead of it and EntityExtract keeps it as it was written and reports it.
+// Replacement tokens and extracted entities are left alone by the other steps.
+func WithEntityHandler(kind options.EntityKind, mode options.EntityMode) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		entities := make(map[options.EntityKind]options.EntityMode, len(option.Entities)+1)
+		for k, v := range option.Entities {
+			entities[k] = v
+		}
+		entities[kind] = mode
+		option.Entities = entities
+	})
+}
+
+// WithEntityToken sets the token EntityReplace writes instead of the given kind of entity
+func WithEntityToken(kind options.EntityKind, token string) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		tokens := make(map[options.EntityKind]string, len(option.EntityTokens)+1)
+		for k, v := range option.EntityTokens {
+			tokens[k] = v
+		}
+		tokens[kind] = token
+		option.EntityTokens = tokens
+	})
+}
+
+// WithEntityReport calls report with every entity the entity handler finds, whatever its mode,
+// which is how EntityExtract entities are collected
+func WithEntityReport(report func(options.Entity)) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.EntityReport = report
+	})
+}
+
+// WithPIIMasker replaces Iranian mobile numbers, national IDs (کد ملی), bank card numbers and Sheba numbers
+// with placeholders, "[MOBILE]", "[NATIONAL_ID]", "[CARD]" and "[SHEBA]" unless WithPIIPlaceholder changes them
+func WithPIIMasker() options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.PIIMasker = true
+	})
+}
+
+// WithPIIPlaceholder sets the placeholder WithPIIMasker writes instead of the given kind of personal data
+func WithPIIPlaceholder(kind options.PIIKind, placeholder string) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		placeholders := make(map[options.PIIKind]string, len(option.PIIPlaceholders)+1)
+		for k, v := range option.PIIPlaceholders {
+			placeholders[k] = v
+		}
+		placeholders[kind] = placeholder
+		option.PIIPlaceholders = placeholders
+	})
+}
+
+// WithPIIReport calls report with every piece of personal data WithPIIMasker replaces,
+// with its span in the original input
+func WithPIIReport(report func(options.MaskedPII)) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.PIIReport = report
+	})
+}
+
 func WithNormalizePunctuations() options.Options {
 	return options.NewFuncOption(func(option *options.NormalizerOptions) {
 		option.NormalizePunctuations = true
@@ -51,11 +194,35 @@ func WithEndsWithEndOfLineChar() options.Options {
 	})
 }
 
-// WithIntToWord do not use WithConvertNumberToLanguage after use this option
+// WithIntToWord replaces numbers written with digits by Persian words, as in "110" to "صد و ده"
 func WithIntToWord() options.Options {
+	return WithIntToWordLanguage(options.LanguageFa)
+}
+
+// WithIntToWordLanguage replaces numbers written with digits by words of the given language,
+// options are : "fa" ("صد و ده"), "ar" ("مائة وعشرة") , "en" ("one hundred ten")
+func WithIntToWordLanguage(language options.Language) options.Options {
 	return options.NewFuncOption(func(option *options.NormalizerOptions) {
 		option.IntToWord = true
-		option.ConvertNumberLang = options.LanguageEn
+		option.IntToWordLang = language
+	})
+}
+
+// WithWordToInt replaces numbers written with words by digits, as in "بیست و پنج هزار" to "25000".
+// The digits follow WithConvertNumberToLanguage. Do not use it together with WithIntToWord.
+func WithWordToInt() options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.WordToInt = true
+	})
+}
+
+// WithPhoneNormalizer finds Iranian phone numbers, as in "۰۹۱۲ ۳۴۵ ۶۷۸۹" or "+98 912-345-6789",
+// and writes them in the given format, options are : "e164" ("+989123456789") , "national" ("09123456789").
+// The digits follow WithConvertNumberToLanguage.
+func WithPhoneNormalizer(format options.PhoneFormat) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Phone = true
+		option.PhoneFormat = format
 	})
 }
 
@@ -66,9 +233,106 @@ func WithConvertNumberToLanguage(language options.Language) options.Options {
 	})
 }
 
+// OffsetMap maps every rune of a normalized text back to a span of runes in the original input
+type OffsetMap = offset.Map
+
+// WithSteps replaces the default pipeline with the given steps, run in the given order.
+// Built-in steps still take their settings (half space, number language, ...) from the other options.
+func WithSteps(steps ...options.Step) options.Options {
+	return options.NewFuncOption(func(option *options.NormalizerOptions) {
+		option.Steps = append([]options.Step{}, steps...)
+	})
+}
+
+// SpecialYehStep splits special "yeh" and "heh" forms into the standard letter and a space
+func SpecialYehStep() options.Step {
+	return internal.BuiltinStep(internal.StepSpecialYeh)
+}
+
+// SpaceStep unifies space characters, and converts half spaces when WithConvertHalfSpaceToSpace is set
+func SpaceStep() options.Step {
+	return internal.BuiltinStep(internal.StepSpaces)
+}
+
+// CharacterStep unifies letters and digits and removes diacritics and new lines
+func CharacterStep() options.Step {
+	return internal.BuiltinStep(internal.StepCharacters)
+}
+
+// ProtectStep is the step behind WithProtectedTerms and WithProtectedPatterns.
+// It should run first, the steps after it leave the protected texts as they are.
+func ProtectStep() options.Step {
+	return internal.BuiltinStep(internal.StepProtect)
+}
+
+// EntityStep is the step behind WithEntityHandler.
+// It should run first, before the other steps change the links.
+func EntityStep() options.Step {
+	return internal.BuiltinStep(internal.StepEntities)
+}
+
+// DictionaryStep is the step that replaces the phrases of WithDictionary.
+// It should run after CharacterStep, the rune mappings are applied by CharacterStep itself.
+func DictionaryStep() options.Step {
+	return internal.BuiltinStep(internal.StepDictionary)
+}
+
+// HalfSpaceFixerStep is the step behind WithHalfSpaceFixer
+func HalfSpaceFixerStep() options.Step {
+	return internal.BuiltinStep(internal.StepHalfSpaceFixer)
+}
+
+// URLRemoverStep is the step behind WithURLRemover
+func URLRemoverStep() options.Step {
+	return internal.BuiltinStep(internal.StepURLRemover)
+}
+
+// PunctuationStep is the step behind WithNormalizePunctuations
+func PunctuationStep() options.Step {
+	return internal.BuiltinStep(internal.StepPunctuations)
+}
+
+// EndOfLineCharStep is the step behind WithEndsWithEndOfLineChar
+func EndOfLineCharStep() options.Step {
+	return internal.BuiltinStep(internal.StepEndOfLineChar)
+}
+
+// SpaceCombinerStep is the step behind WithSpaceCombiner
+func SpaceCombinerStep() options.Step {
+	return internal.BuiltinStep(internal.StepSpaceCombiner)
+}
+
+// OuterSpaceRemoverStep is the step behind WithOuterSpaceRemover
+func OuterSpaceRemoverStep() options.Step {
+	return internal.BuiltinStep(internal.StepOuterSpaceRemover)
+}
+
+// IntToWordStep is the step behind WithIntToWord
+func IntToWordStep() options.Step {
+	return internal.BuiltinStep(internal.StepIntToWord)
+}
+
+// WordToIntStep is the step behind WithWordToInt
+func WordToIntStep() options.Step {
+	return internal.BuiltinStepWithOptions(internal.StepWordToInt, withDetectors(options.DefaultOptions))
+}
+
+// PIIMaskerStep is the step behind WithPIIMasker
+func PIIMaskerStep() options.Step {
+	return internal.BuiltinStepWithOptions(internal.StepPIIMasker, withDetectors(options.DefaultOptions))
+}
+
+// PhoneStep is the step behind WithPhoneNormalizer
+func PhoneStep() options.Step {
+	return internal.BuiltinStepWithOptions(internal.StepPhone, withDetectors(options.DefaultOptions))
+}
+
 type Normalize interface {
 	FindHalfSpace(input, halfSpace string) string
 	BasicNormalizer(input string) string
+	BasicNormalizerWithOffsets(input string) (string, OffsetMap)
+	BasicNormalizerReader(r io.Reader) io.Reader
+	BasicNormalizerStream(dst io.Writer, src io.Reader) error
 	VariationSelectorsRemover(input []string) []string
 	BasicNormalizerArray(input []string) []string
 	BasicNormalizerSlice(input []string) []string
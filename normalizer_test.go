@@ -1,8 +1,15 @@
 package seperno
 
 import (
+	"io"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"testing/iotest"
 
+	"github.com/snapp-incubator/seperno/pkg/offset"
 	"github.com/snapp-incubator/seperno/pkg/options"
 )
 
@@ -40,6 +47,61 @@ func TestNormalize_BasicNormalizer(t *testing.T) {
 			},
 			want: "تست ",
 		},
+		{
+			name: "should remove only urls with a scheme",
+			args: args{
+				input: "Www.Snapp.ir و snapp.ir/ride سلام",
+				ops:   []options.Options{WithURLRemover()},
+			},
+			want: "www.snapp.ir و snapp.ir/ride سلام",
+		},
+		{
+			name: "should lowercase by default",
+			args: args{
+				input: "کد SNAPP20 Straße",
+			},
+			want: "کد snapp20 straße",
+		},
+		{
+			name: "should preserve case",
+			args: args{
+				input: "کد SNAPP20 Straße",
+				ops:   []options.Options{WithCaseMode(options.CasePreserve)},
+			},
+			want: "کد SNAPP20 Straße",
+		},
+		{
+			name: "should fold case",
+			args: args{
+				input: "کد SNAPP20 Straße ΣΟΦΟΣ",
+				ops:   []options.Options{WithCaseMode(options.CaseFold)},
+			},
+			want: "کد snapp20 strasse σοφοσ",
+		},
+		{
+			name: "should keep diacritics",
+			args: args{
+				input: "بِسْمِ اللّهِ",
+				ops:   []options.Options{WithDiacritics(options.DiacriticsKeep)},
+			},
+			want: "بِسْمِ اللّهِ",
+		},
+		{
+			name: "should keep kurdish letters",
+			args: args{
+				input: "ڕۆژی باش",
+				ops:   []options.Options{WithScriptProfile(options.ScriptSorani)},
+			},
+			want: "ڕۆژی باش",
+		},
+		{
+			name: "should fix half spaces",
+			args: args{
+				input: "ما نمي خواهيم كتاب ها را بزرگ تر كنيم",
+				ops:   []options.Options{WithHalfSpaceFixer()},
+			},
+			want: "ما نمی‌خواهیم کتاب‌ها را بزرگ‌تر کنیم",
+		},
 		{
 			name: "should combine spaces",
 			args: args{
@@ -74,6 +136,81 @@ func TestNormalize_BasicNormalizer(t *testing.T) {
 			},
 			want: "خیابان پانزده خرداد",
 		},
+		{
+			name: "Should replace number with words and keep the number language",
+			args: args{
+				input: "خیابان ۱۵ خرداد پلاک 7",
+				ops: []options.Options{
+					WithConvertNumberToLanguage(options.LanguageFa),
+					WithIntToWord(),
+				},
+			},
+			want: "خیابان پانزده خرداد پلاک هفت",
+		},
+		{
+			name: "Should replace number with English words",
+			args: args{
+				input: "street 125",
+				ops: []options.Options{
+					WithIntToWordLanguage(options.LanguageEn),
+				},
+			},
+			want: "street one hundred twenty-five",
+		},
+		{
+			name: "Should replace number with Arabic words",
+			args: args{
+				input: "شارع ٢٥",
+				ops: []options.Options{
+					WithIntToWordLanguage(options.LanguageAr),
+					WithConvertNumberToLanguage(options.LanguageAr),
+				},
+			},
+			want: "شارع خمسة وعشرون",
+		},
+		{
+			name: "Should write phone numbers in E.164 form",
+			args: args{
+				input: "شماره ۰۹۱۲ ۳۴۵ ۶۷۸۹ و 00989351234567",
+				ops: []options.Options{
+					WithPhoneNormalizer(options.PhoneFormatE164),
+				},
+			},
+			want: "شماره +989123456789 و +989351234567",
+		},
+		{
+			name: "Should write phone numbers in national form",
+			args: args{
+				input: "تماس: +98 912-345-6789 یا صفر نهصد و دوازده سیصد و چهل و پنج شصت و هفت هشتاد و نه",
+				ops: []options.Options{
+					WithPhoneNormalizer(options.PhoneFormatNational),
+					WithConvertNumberToLanguage(options.LanguageFa),
+				},
+			},
+			want: "تماس: ۰۹۱۲۳۴۵۶۷۸۹ یا ۰۹۱۲۳۴۵۶۷۸۹",
+		},
+		{
+			name: "Should not spell phone numbers out",
+			args: args{
+				input: "۲ نفر ۰۹۱۲ ۳۴۵ ۶۷۸۹",
+				ops: []options.Options{
+					WithPhoneNormalizer(options.PhoneFormatNational),
+					WithIntToWord(),
+				},
+			},
+			want: "دو نفر 09123456789",
+		},
+		{
+			name: "Should read phone numbers written with words before other numbers",
+			args: args{
+				input: "دو نفر صفر نهصد و دوازده سیصد و چهل و پنج شصت و هفت هشتاد و نه",
+				ops: []options.Options{
+					WithPhoneNormalizer(options.PhoneFormatNational),
+					WithWordToInt(),
+				},
+			},
+			want: "2 نفر 09123456789",
+		},
 		{
 			name: "Should replace number with Persian Number",
 			args: args{
@@ -104,6 +241,57 @@ func TestNormalize_BasicNormalizer(t *testing.T) {
 			},
 			want: "خیابان ١٥ خرداد",
 		},
+		{
+			name: "Should replace number words with digits",
+			args: args{
+				input: "بیست و پنج هزار تومان",
+				ops: []options.Options{
+					WithWordToInt(),
+				},
+			},
+			want: "25000 تومان",
+		},
+		{
+			name: "Should replace number words with Persian digits",
+			args: args{
+				input: "خیابان بیست و چهار پلاک ده طبقه سوم",
+				ops: []options.Options{
+					WithWordToInt(),
+					WithConvertNumberToLanguage(options.LanguageFa),
+				},
+			},
+			want: "خیابان ۲۴ پلاک ۱۰ طبقه ۳",
+		},
+		{
+			name: "Should replace fractional number words with digits",
+			args: args{
+				input: "دو و نیم کیلو، یک سوم",
+				ops: []options.Options{
+					WithWordToInt(),
+				},
+			},
+			want: "2.5 کیلو، یک سوم",
+		},
+		{
+			name: "Should keep numbers written only with digits",
+			args: args{
+				input: "0912 هزار",
+				ops: []options.Options{
+					WithWordToInt(),
+				},
+			},
+			want: "0912 1000",
+		},
+		{
+			name: "Should keep digits after a number written with words apart",
+			args: args{
+				input: "بیست و پنج هزار 12",
+				ops: []options.Options{
+					WithWordToInt(),
+				},
+			},
+			want: "25000 12",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -113,3 +301,271 @@ func TestNormalize_BasicNormalizer(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalize_WithSteps(t *testing.T) {
+	upperURL := options.NewFuncStep("upper_url", func(input string) string {
+		return strings.ReplaceAll(input, "HTTPS://", "https://")
+	})
+	tests := []struct {
+		name  string
+		input string
+		ops   []options.Options
+		want  string
+	}{
+		{
+			name:  "default pipeline keeps historical order",
+			input: "تست 110  ",
+			ops:   []options.Options{WithIntToWord(), WithOuterSpaceRemover()},
+			want:  "تست صد و ده",
+		},
+		{
+			name:  "remove urls before lowercasing",
+			input: "تست HTTPS://Example.com",
+			ops: []options.Options{WithSteps(
+				upperURL, URLRemoverStep(), SpecialYehStep(), SpaceStep(), CharacterStep(), OuterSpaceRemoverStep(),
+			)},
+			want: "تست",
+		},
+		{
+			name:  "spell numbers before removing outer spaces",
+			input: "کوچه 110",
+			ops: []options.Options{WithSteps(
+				SpaceStep(), CharacterStep(), IntToWordStep(), SpaceCombinerStep(),
+			)},
+			want: "کوچه صد و ده",
+		},
+		{
+			name:  "number words to digits",
+			input: "کوچه بیست و پنج",
+			ops:   []options.Options{WithSteps(SpaceStep(), CharacterStep(), WordToIntStep())},
+			want:  "کوچه 25",
+		},
+		{
+			name:  "built-in steps use the normalizer options",
+			input: "آسمان‌آبی ۱۵",
+			ops: []options.Options{
+				WithConvertHalfSpaceToSpace(),
+				WithConvertNumberToLanguage(options.LanguageAr),
+				WithSteps(SpaceStep(), CharacterStep()),
+			},
+			want: "اسمان ابی ١٥",
+		},
+		{
+			name:  "user-defined step",
+			input: "سلام دنیا",
+			ops: []options.Options{WithSteps(
+				CharacterStep(),
+				options.NewFuncStep("reverse_words", func(input string) string {
+					words := strings.Fields(input)
+					slices.Reverse(words)
+					return strings.Join(words, " ")
+				}),
+			)},
+			want: "دنیا سلام",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewNormalize(tt.ops...).BasicNormalizer(tt.input); got != tt.want {
+				t.Errorf("BasicNormalizer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordToIntStep_Apply(t *testing.T) {
+	if got, want := WordToIntStep().Apply("پلاک صد و ده"), "پلاک 110"; got != want {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+}
+
+func TestPIIMaskerStep_Apply(t *testing.T) {
+	if got, want := PIIMaskerStep().Apply("کارت 6037991234567893"), "کارت [CARD]"; got != want {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+}
+
+func TestPhoneStep_Apply(t *testing.T) {
+	if got, want := PhoneStep().Apply("شماره 0912 345 6789"), "شماره +989123456789"; got != want {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+}
+
+func TestNormalize_WordToIntReader(t *testing.T) {
+	normalizers := []Normalize{
+		NewNormalize(WithWordToInt(), WithSpaceCombiner()),
+		NewNormalize(WithWordToInt(), WithNormalizePunctuations()),
+	}
+	inputs := []string{
+		strings.Repeat("خیابان بیست و پنج پلاک صد و ده، دو و نیم میلیون تومان ", 20),
+		"ےبیست و پنج هزار",
+		"کتاب یک سوم7893 و دو",
+		"قیمت 7893 و یک \n  ربع است",
+		"دمای منفی . \n  - 7893 درجه",
+	}
+
+	for _, normalizer := range normalizers {
+		for _, input := range inputs {
+			got, err := io.ReadAll(normalizer.BasicNormalizerReader(iotest.OneByteReader(strings.NewReader(input))))
+			if err != nil {
+				t.Fatalf("BasicNormalizerReader() error = %v", err)
+			}
+			if want := normalizer.BasicNormalizer(input); string(got) != want {
+				t.Errorf("BasicNormalizerReader(%.40q) = %.80q, want %.80q", input, got, want)
+			}
+		}
+	}
+}
+
+func TestNormalize_PhoneReader(t *testing.T) {
+	normalizer := NewNormalize(WithPhoneNormalizer(options.PhoneFormatNational), WithSpaceCombiner())
+	input := strings.Repeat("شماره من ۰۹۱۲ سیصد و چهل و پنج شصت و هفت هشتاد و نه است و تلفن (021) 8877-6655 ", 20)
+
+	got, err := io.ReadAll(normalizer.BasicNormalizerReader(iotest.OneByteReader(strings.NewReader(input))))
+	if err != nil {
+		t.Fatalf("BasicNormalizerReader() error = %v", err)
+	}
+	if want := normalizer.BasicNormalizer(input); string(got) != want || strings.Count(want, "09123456789") != 20 {
+		t.Errorf("BasicNormalizerReader() = %.80q, want %.80q", got, want)
+	}
+}
+
+func TestNormalize_PIIMaskerReader(t *testing.T) {
+	normalizers := []Normalize{
+		NewNormalize(WithPIIMasker(), WithSpaceCombiner()),
+		NewNormalize(WithPIIMasker(), WithHalfSpaceFixer()),
+	}
+	inputs := []string{
+		strings.Repeat("کارت من 6037 9912 3456 7893 است و کد ملی 0499370899 و شبا IR062960000000100324200001 ", 20),
+		strings.Repeat("کارت6037 9912 3456 7893 و ۶۰۳۷۹۹۱۲۳۴۵۶۷۸۹۳ یا 0912 345 6789 ", 20),
+		"6037991234567893می خواهم که بروم",
+	}
+
+	for _, normalizer := range normalizers {
+		for _, input := range inputs {
+			got, err := io.ReadAll(normalizer.BasicNormalizerReader(iotest.OneByteReader(strings.NewReader(input))))
+			if err != nil {
+				t.Fatalf("BasicNormalizerReader() error = %v", err)
+			}
+			if want := normalizer.BasicNormalizer(input); string(got) != want || !strings.Contains(want, "[CARD]") {
+				t.Errorf("BasicNormalizerReader(%.40q) = %.80q, want %.80q", input, got, want)
+			}
+		}
+	}
+	if got, want := NewNormalize(WithPIIMasker(), WithHalfSpaceFixer()).BasicNormalizer("6037991234567893می خواهم"),
+		"[CARD]می\u200cخواهم"; got != want {
+		t.Errorf("BasicNormalizer() = %q, want %q", got, want)
+	}
+}
+
+func TestNormalize_PIIMasker(t *testing.T) {
+	var masked []options.MaskedPII
+	normalizer := NewNormalize(
+		WithPIIMasker(),
+		WithPIIPlaceholder(options.PIICard, "***"),
+		WithPIIReport(func(pii options.MaskedPII) { masked = append(masked, pii) }),
+		WithNormalizePunctuations(),
+		WithSpaceCombiner(),
+	)
+	input := "کارت: 6037-9975-1234-5670، موبایل ۰۹۱۲ ۳۴۵ ۶۷۸۹ و کد ملی 0499370899"
+
+	want := "کارت *** موبایل [MOBILE] و کد ملی [NATIONAL_ID]"
+	if got := normalizer.BasicNormalizer(input); got != want {
+		t.Errorf("BasicNormalizer() = %v, want %v", got, want)
+	}
+	wantMasked := []options.MaskedPII{
+		{Kind: options.PIICard, Text: "6037_9975_1234_5670", Span: offset.Span{Start: 6, End: 25}},
+		{Kind: options.PIIMobile, Text: "0912 345 6789", Span: offset.Span{Start: 34, End: 47}},
+		{Kind: options.PIINationalID, Text: "0499370899", Span: offset.Span{Start: 57, End: 67}},
+	}
+	if !reflect.DeepEqual(masked, wantMasked) {
+		t.Errorf("reported %+v, want %+v", masked, wantMasked)
+	}
+}
+
+func TestNormalize_PIIMaskerWithPunctuations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "national id with dashes", input: "کد ملی 049-937089-9", want: "کد ملی [NATIONAL_ID]"},
+		{name: "mobile with a country code", input: "شماره +98 912-345-6789 است.", want: "شماره [MOBILE] است "},
+		{name: "placeholders are left alone", input: "کارت 6037-9975-1234-5670!", want: "کارت [CARD] "},
+	}
+	normalizer := NewNormalize(WithPIIMasker(), WithNormalizePunctuations(), WithSpaceCombiner())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizer.BasicNormalizer(tt.input); got != tt.want {
+				t.Errorf("BasicNormalizer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize_EntityHandler(t *testing.T) {
+	var extracted []options.Entity
+	normalizer := NewNormalize(
+		WithEntityHandler(options.EntityURL, options.EntityExtract),
+		WithEntityHandler(options.EntityEmail, options.EntityReplace),
+		WithEntityHandler(options.EntityMention, options.EntityReplace),
+		WithEntityHandler(options.EntityHashtag, options.EntityRemove),
+		WithEntityToken(options.EntityMention, "<user>"),
+		WithEntityReport(func(entity options.Entity) {
+			if entity.Mode == options.EntityExtract {
+				extracted = append(extracted, entity)
+			}
+		}),
+		WithNormalizePunctuations(),
+		WithSpaceCombiner(),
+		WithOuterSpaceRemover(),
+	)
+	input := "سلام @Ali_Reza، سفارش: Snapp.ir/Ride?ID=12 یا info@snapp.ir #اسنپ"
+
+	want := "سلام <user> سفارش Snapp.ir/Ride?ID=12 یا [EMAIL]"
+	if got := normalizer.BasicNormalizer(input); got != want {
+		t.Errorf("BasicNormalizer() = %v, want %v", got, want)
+	}
+	wantExtracted := []options.Entity{
+		{Kind: options.EntityURL, Mode: options.EntityExtract, Text: "Snapp.ir/Ride?ID=12", Span: offset.Span{Start: 23, End: 42}},
+	}
+	if !reflect.DeepEqual(extracted, wantExtracted) {
+		t.Errorf("extracted %+v, want %+v", extracted, wantExtracted)
+	}
+}
+
+func TestNormalize_Dictionary(t *testing.T) {
+	dict, err := options.ReadDictionary(strings.NewReader("[runes]\nي => ی\n[phrases]\nخ. => خیابان\nاسنپ فود => اسنپ‌فود\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	normalizer := NewNormalize(
+		WithDictionary(dict),
+		WithDictionary(options.Dictionary{Phrases: map[string]string{"ولیعصر": "ولی‌عصر"}}),
+		WithNormalizePunctuations(),
+		WithSpaceCombiner(),
+	)
+	input := "سفارش  اسنپ   فود به خ. وليعصر، كوچه ۲"
+
+	want := "سفارش اسنپ‌فود به خیابان ولی‌عصر کوچه 2"
+	if got := normalizer.BasicNormalizer(input); got != want {
+		t.Errorf("BasicNormalizer() = %v, want %v", got, want)
+	}
+}
+
+func TestNormalize_ProtectedTerms(t *testing.T) {
+	normalizer := NewNormalize(
+		WithProtectedTerms("SNAPP20", "iPhone 15"),
+		WithProtectedPatterns(regexp.MustCompile(`https?://\S+`)),
+		WithURLRemover(),
+		WithNormalizePunctuations(),
+		WithSpaceCombiner(),
+		WithOuterSpaceRemover(),
+	)
+	input := "كد SNAPP20 برای iPhone 15 در https://Snapp.ir/Promo?ID=1 ، Snapp!"
+
+	want := "کد SNAPP20 برای iPhone 15 در https://Snapp.ir/Promo?ID=1 snapp"
+	if got := normalizer.BasicNormalizer(input); got != want {
+		t.Errorf("BasicNormalizer() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+package lfd
+
+import (
+	"fmt"
+	"time"
+)
+
+// jalaliBreaks are the years the 33-year cycles of leap years restart at,
+// the calendar is only computed for years from jalaliBreaks[0] up to the last break
+var jalaliBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+// JalaliDate is a date of the Solar Hijri calendar. Year is zero when the date was written without a year,
+// as in "پانزدهم مهرماه".
+type JalaliDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// JalaliFromTime returns the Jalali date of the day t falls on in its location
+func JalaliFromTime(t time.Time) JalaliDate {
+	y, m, d := t.Date()
+	days := daysSinceEpoch(y, m, d)
+
+	// the Jalali year starts in March, so it is 621 or 622 years behind the Gregorian year
+	year := y - 621
+	leap, march, ok := jalaliCalendar(year)
+	if !ok {
+		return JalaliDate{}
+	}
+	k := days - daysSinceEpoch(y, time.March, march)
+	if k < 0 {
+		// the day is at the end of the previous year, which was a leap year when this one is 1 year into the cycle
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	} else if k <= 185 {
+		return JalaliDate{Year: year, Month: 1 + k/31, Day: k%31 + 1}
+	} else {
+		k -= 186
+	}
+	return JalaliDate{Year: year, Month: 7 + k/30, Day: k%30 + 1}
+}
+
+// Time returns the start of the Gregorian day of the date in loc.
+// It returns the zero time.Time when the date is not valid.
+func (d JalaliDate) Time(loc *time.Location) time.Time {
+	if !d.IsValid() {
+		return time.Time{}
+	}
+	_, march, _ := jalaliCalendar(d.Year)
+	gy := d.Year + 621
+	days := daysSinceEpoch(gy, time.March, march) + (d.Month-1)*31 - d.Month/7*(d.Month-7) + d.Day - 1
+	date := time.Unix(int64(days)*secondsPerDay, 0).UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+// IsValid reports whether the date has a month and a day that exist in its year,
+// in the years the calendar is computed for
+func (d JalaliDate) IsValid() bool {
+	if _, _, ok := jalaliCalendar(d.Year); !ok || d.Month < 1 || d.Month > 12 || d.Day < 1 {
+		return false
+	}
+	return d.Day <= JalaliMonthLength(d.Year, d.Month)
+}
+
+// String returns the date as "1402/07/15"
+func (d JalaliDate) String() string {
+	return fmt.Sprintf("%04d/%02d/%02d", d.Year, d.Month, d.Day)
+}
+
+// IsJalaliLeapYear reports whether Esfand has 30 days in the given year
+func IsJalaliLeapYear(year int) bool {
+	leap, _, ok := jalaliCalendar(year)
+	return ok && leap == 0
+}
+
+// JalaliMonthLength returns the number of days of a month, 0 for months that do not exist
+func JalaliMonthLength(year, month int) int {
+	switch {
+	case month < 1 || month > 12:
+		return 0
+	case month <= 6:
+		return 31
+	case month <= 11 || IsJalaliLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// daysSinceEpoch returns the number of days from 1970-01-01 to the given Gregorian date
+func daysSinceEpoch(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+}
+
+// jalaliCalendar returns the position of year in its 4-year leap cycle, 0 for leap years,
+// and the day of March of its Gregorian year that the Jalali year starts on.
+// It follows the algorithm of Kazimierz M. Borkowski, "The Persian calendar for 3000 years".
+func jalaliCalendar(year int) (leap int, march int, ok bool) {
+	if year < jalaliBreaks[0] || year >= jalaliBreaks[len(jalaliBreaks)-1] {
+		return 0, 0, false
+	}
+
+	gy := year + 621
+	leapJ := -14
+	jp := jalaliBreaks[0]
+	jump := 0
+	for _, jm := range jalaliBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march, true
+}
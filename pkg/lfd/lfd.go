@@ -1,11 +1,183 @@
 package lfd
 
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
 type DetectedNumber struct {
+	// Number is the value of the number, or its integer part when it is fractional
 	Number     int64
 	StartIndex int
 	EndIndex   int
+	// Fraction is the exact value of a fractional number such as "دو و نیم" or "۲٫۵",
+	// and is zero for whole numbers. Fractions are only detected when PersianNumberDetector.Fractions is set.
+	Fraction Rational
+	// Ordinal is true for ordinal numbers such as "سوم" or "بیست و یکمین", and false for cardinal numbers.
+	// Ordinal, Kind and Text are only set when PersianNumberDetector.Describe is set.
+	Ordinal bool
+	// Kind tells how the number is written or what it looks like
+	Kind Kind
+	// Text is the part of the input the number was detected in
+	Text string
+}
+
+// Kind is the kind of a detected number.
+// When several kinds apply, the first one in the order of the constants below is reported,
+// so "منفی دو و نیم" is negative and "۲ میلیون" is mixed.
+type Kind string
+
+const (
+	KindNegative Kind = "negative" // "منفی پنج" or "-5"
+	KindFraction Kind = "fraction" // "دو و نیم" or "۲٫۵"
+	KindOrdinal  Kind = "ordinal"  // "سوم" or "۲۰ام"
+	KindPhone    Kind = "phone"    // digits shaped like a phone number, as in "09121234567"
+	KindYear     Kind = "year"     // four digits in the range of recent Jalali or Gregorian years, as in "۱۴۰۲"
+	KindMixed    Kind = "mixed"    // digits and words, as in "۲ میلیون"
+	KindWords    Kind = "words"    // only words, as in "بیست و پنج"
+	KindDigits   Kind = "digits"   // only digits, as in "25"
+)
+
+// IsFraction reports whether the detected number is not a whole number
+func (d DetectedNumber) IsFraction() bool {
+	return d.Fraction.Denominator != 0
+}
+
+// Value returns the exact value of the detected number
+func (d DetectedNumber) Value() Rational {
+	if d.IsFraction() {
+		return d.Fraction
+	}
+	return Rational{Numerator: d.Number, Denominator: 1}
+}
+
+// Float64 returns the value of the detected number as a float64
+func (d DetectedNumber) Float64() float64 {
+	return d.Value().Float64()
+}
+
+// Rational is an exact fraction in lowest terms with a positive denominator.
+// The zero value means "not set".
+type Rational struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// Rat returns the value as a *big.Rat
+func (r Rational) Rat() *big.Rat {
+	if r.Denominator == 0 {
+		return new(big.Rat)
+	}
+	return big.NewRat(r.Numerator, r.Denominator)
+}
+
+// Float64 returns the nearest float64 value
+func (r Rational) Float64() float64 {
+	f, _ := r.Rat().Float64()
+	return f
+}
+
+// String returns the value as "numerator/denominator"
+func (r Rational) String() string {
+	return r.Rat().String()
 }
 
 type NumberDetector interface {
 	DetectNumbers(text string) []DetectedNumber
 }
+
+type DetectedDate struct {
+	Date       JalaliDate
+	StartIndex int
+	EndIndex   int
+	// Text is the part of the input the date was detected in
+	Text string
+}
+
+type DateDetector interface {
+	DetectDates(text string) []DetectedDate
+}
+
+type DetectedTime struct {
+	Kind TimeKind
+	// Clock is the time of day of clock times, as in "ساعت پنج و نیم عصر" or "۱۷:۳۰"
+	Clock Clock
+	// Duration is the length of durations, as in "دو ساعت و بیست دقیقه" or "نیم ساعت"
+	Duration time.Duration
+	// FromNow is true for durations counted from now, as in "ربع ساعت دیگه"
+	FromNow    bool
+	StartIndex int
+	EndIndex   int
+	// Text is the part of the input the time was detected in
+	Text string
+}
+
+// TimeKind tells whether a detected time is a time of day or a duration
+type TimeKind string
+
+const (
+	TimeKindClock    TimeKind = "clock"    // "ساعت پنج عصر" or "۱۷:۳۰"
+	TimeKindDuration TimeKind = "duration" // "دو ساعت و بیست دقیقه"
+)
+
+// Clock is a time of day on the 24-hour clock
+type Clock struct {
+	Hour   int
+	Minute int
+	Second int
+}
+
+// String returns the time as "17:30", or "17:30:15" when it has seconds
+func (c Clock) String() string {
+	if c.Second != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", c.Hour, c.Minute, c.Second)
+	}
+	return fmt.Sprintf("%02d:%02d", c.Hour, c.Minute)
+}
+
+// On returns the time of the clock on the day of t, in the location of t
+func (c Clock) On(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, c.Hour, c.Minute, c.Second, 0, t.Location())
+}
+
+type TimeDetector interface {
+	DetectTimes(text string) []DetectedTime
+}
+
+type DetectedPhone struct {
+	// Number is the phone number in E.164 form, as in "+989123456789"
+	Number     string
+	Type       PhoneType
+	StartIndex int
+	EndIndex   int
+	// Text is the part of the input the phone number was detected in
+	Text string
+}
+
+// PhoneType tells whether a phone number is a mobile or a landline number
+type PhoneType string
+
+const (
+	PhoneTypeMobile   PhoneType = "mobile"   // "09123456789"
+	PhoneTypeLandline PhoneType = "landline" // "02188776655"
+)
+
+type PhoneDetector interface {
+	DetectPhones(text string) []DetectedPhone
+}
+
+type DetectedPII struct {
+	Kind       options.PIIKind
+	StartIndex int
+	EndIndex   int
+	// Text is the part of the input the personal data was detected in
+	Text string
+}
+
+type PIIDetector interface {
+	DetectPII(text string) []DetectedPII
+}
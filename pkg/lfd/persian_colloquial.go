@@ -0,0 +1,61 @@
+package lfd
+
+import "strings"
+
+// colloquialNumberMap maps informal spoken forms of number words to their written form
+var colloquialNumberMap = map[string]string{
+	"یه": "یک", "چار": "چهار", "شیش": "شش", "هف": "هفت", "هش": "هشت",
+	"یازه": "یازده", "دوازه": "دوازده", "سیزه": "سیزده", "چارده": "چهارده",
+	"پونزده": "پانزده", "پونزه": "پانزده", "شونزده": "شانزده", "شونزه": "شانزده",
+	"هیفده": "هفده", "هیوده": "هفده", "هیجده": "هجده", "هیژده": "هجده", "نوزه": "نوزده",
+	"پنجا": "پنجاه", "شیشصد": "ششصد", "هفصد": "هفتصد", "هشصد": "هشتصد",
+}
+
+// colloquialSuffixes are written right after a number in chat text, as in "دوتا" or "صدتومن"
+var colloquialSuffixes = []string{"تا", "تومن", "تومان"}
+
+// colloquialTokens rewrites tokens for the colloquial mode: a counter or currency suffix glued to a number word
+// becomes a token of its own, and informal forms are replaced with the written word.
+// Positions are kept, so the number still points at the original text.
+func colloquialTokens(tokens []Token) []Token {
+	result := make([]Token, 0, len(tokens))
+	for _, token := range tokens {
+		if number, suffix, ok := splitColloquialSuffix(token.Value); ok {
+			numberEnd := token.StartIndex + len([]rune(number)) - 1
+			result = append(result,
+				Token{Value: colloquialWord(number), StartIndex: token.StartIndex, EndIndex: numberEnd},
+				Token{Value: suffix, StartIndex: numberEnd + 1, EndIndex: token.EndIndex},
+			)
+			continue
+		}
+		token.Value = colloquialWord(token.Value)
+		result = append(result, token)
+	}
+	return result
+}
+
+// splitColloquialSuffix splits a word such as "سه‌تا" or "پونصدتومن" into the number word and the suffix
+func splitColloquialSuffix(word string) (string, string, bool) {
+	for _, suffix := range colloquialSuffixes {
+		number, found := strings.CutSuffix(word, suffix)
+		if !found || number == "" {
+			continue
+		}
+		written := colloquialWord(number)
+		if _, ok := persianNumberMap[written]; ok {
+			return number, suffix, true
+		}
+		if _, ok := scaleNumberMap[written]; ok {
+			return number, suffix, true
+		}
+	}
+	return "", "", false
+}
+
+// colloquialWord returns the written form of an informal number word, or the word itself
+func colloquialWord(word string) string {
+	if written, ok := colloquialNumberMap[word]; ok {
+		return written
+	}
+	return word
+}
@@ -0,0 +1,242 @@
+package lfd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+const (
+	monthWord = "ماه" // as in "مهرماه" or "مهر ماه"
+	yearWord  = "سال" // as in "۱۵ مهر سال ۱۴۰۲"
+
+	// minNumericYear and maxNumericYear bound the years of numeric dates, so "2023/10/07" is not read as a Jalali date
+	minNumericYear = 1200
+	maxNumericYear = 1599
+	// minWordYear is the smallest year written after a month name, so "۱۵ مهر ۲ نفر" has no year
+	minWordYear = 1000
+
+	dateSeparators = "/_."
+)
+
+var (
+	jalaliMonthNames = []string{
+		"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند",
+	}
+
+	// monthNameMap maps the month names, in the form NormalizeCharacters leaves them in, to their numbers
+	monthNameMap = buildMonthNameMap()
+
+	// numericDateRegex matches "1402/07/15", "15-07-1402" or "1402.7.15" once NormalizeCharacters
+	// has made digits English and dashes "_"
+	numericDateRegex = regexp.MustCompile(`(\d{1,4})([/_.])(\d{1,2})([/_.])(\d{1,4})`)
+)
+
+// MonthName returns the Persian name of the month of the date, "مهر" for the seventh month
+func (d JalaliDate) MonthName() string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	return jalaliMonthNames[d.Month-1]
+}
+
+type PersianDateDetector struct{}
+
+// DetectDates finds Jalali dates written with digits, as in "۱۴۰۲/۰۷/۱۵" or "۱۵/۰۷/۱۴۰۲",
+// or with a month name after a day in digits or words, as in "۱۵ مهر ۱۴۰۲" or "پانزدهم مهرماه"
+func (f *PersianDateDetector) DetectDates(text string) []DetectedDate {
+	if text == "" {
+		return []DetectedDate{}
+	}
+
+	input := []rune(text)
+	normalized := internal.NewNormalizer(options.DefaultOptions).NormalizeCharacters(text)
+
+	dates := detectNumericDates(normalized)
+	numbers := (&PersianNumberDetector{Describe: true}).DetectNumbers(text)
+	for i, day := range numbers {
+		date, ok := parseNamedDate(normalized, numbers, i)
+		if !ok || overlapsDate(dates, day.StartIndex, date.EndIndex) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].StartIndex < dates[j].StartIndex })
+	for i := range dates {
+		dates[i].Text = string(input[dates[i].StartIndex : dates[i].EndIndex+1])
+	}
+	return dates
+}
+
+// detectNumericDates finds the dates written with digits and separators in normalized text
+func detectNumericDates(normalized []rune) []DetectedDate {
+	text := string(normalized)
+	dates := make([]DetectedDate, 0)
+	for _, loc := range numericDateRegex.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if text[loc[4]:loc[5]] != text[loc[8]:loc[9]] || touchesDigits(text, start, end) {
+			continue
+		}
+		first, month, last := text[loc[2]:loc[3]], text[loc[6]:loc[7]], text[loc[10]:loc[11]]
+
+		var date JalaliDate
+		switch {
+		case len(first) == 4 && len(last) <= 2:
+			date = JalaliDate{Year: atoi(first), Month: atoi(month), Day: atoi(last)}
+		case len(last) == 4 && len(first) <= 2:
+			date = JalaliDate{Year: atoi(last), Month: atoi(month), Day: atoi(first)}
+		default:
+			continue
+		}
+		if date.Year < minNumericYear || date.Year > maxNumericYear || !date.IsValid() {
+			continue
+		}
+		dates = append(dates, DetectedDate{
+			Date:       date,
+			StartIndex: len([]rune(text[:start])),
+			EndIndex:   len([]rune(text[:end])) - 1,
+		})
+	}
+	return dates
+}
+
+// touchesDigits reports whether text[start:end] is part of a longer run of digits and separators, as in "1402/07/15/3"
+func touchesDigits(text string, start, end int) bool {
+	if start > 0 && (isASCIIDigit(text[start-1]) || (start > 1 && strings.IndexByte(dateSeparators, text[start-1]) >= 0 && isASCIIDigit(text[start-2]))) {
+		return true
+	}
+	if end < len(text) && (isASCIIDigit(text[end]) || (end+1 < len(text) && strings.IndexByte(dateSeparators, text[end]) >= 0 && isASCIIDigit(text[end+1]))) {
+		return true
+	}
+	return false
+}
+
+// parseNamedDate parses a date made of numbers[i] as the day, a month name and an optional year after it
+func parseNamedDate(normalized []rune, numbers []DetectedNumber, i int) (DetectedDate, bool) {
+	day := numbers[i]
+	if day.IsFraction() || day.Number < 1 || day.Number > 31 {
+		return DetectedDate{}, false
+	}
+	month, end, ok := monthNameAt(normalized, skipSpaces(normalized, day.EndIndex+1))
+	if !ok {
+		return DetectedDate{}, false
+	}
+	date := DetectedDate{Date: JalaliDate{Month: month, Day: int(day.Number)}, StartIndex: day.StartIndex, EndIndex: end - 1}
+
+	pos := skipSpaces(normalized, end)
+	if hasWordAt(normalized, pos, yearWord) {
+		pos = skipSpaces(normalized, pos+len([]rune(yearWord)))
+	}
+	if i+1 < len(numbers) {
+		year := numbers[i+1]
+		if year.StartIndex == pos && !year.IsFraction() && !year.Ordinal && year.Number >= minWordYear {
+			date.Date.Year = int(year.Number)
+			date.EndIndex = year.EndIndex
+		}
+	}
+
+	maxDay := JalaliMonthLength(date.Date.Year, month)
+	if date.Date.Year == 0 && month == 12 {
+		maxDay = 30
+	}
+	if (date.Date.Year != 0 && !date.Date.IsValid()) || date.Date.Day > maxDay {
+		return DetectedDate{}, false
+	}
+	return date, true
+}
+
+// monthNameAt matches a month name at normalized[pos], also followed by "ماه" as in "مهرماه" or "مهر ماه",
+// and returns the month and the index right after the match
+func monthNameAt(normalized []rune, pos int) (int, int, bool) {
+	monthWordLength := len([]rune(monthWord))
+	for name, month := range monthNameMap {
+		if !hasPrefixAt(normalized, pos, name) {
+			continue
+		}
+		end := pos + len([]rune(name))
+		switch {
+		case hasWordAt(normalized, end, monthWord):
+			end += monthWordLength
+		case isLetterAt(normalized, end):
+			// another word such as "مهربان" or "دیروز"
+			continue
+		default:
+			if next := skipMonthSeparator(normalized, end); hasWordAt(normalized, next, monthWord) {
+				end = next + monthWordLength
+			}
+		}
+		return month, end, true
+	}
+	return 0, 0, false
+}
+
+// hasWordAt reports whether word is at normalized[pos] and no letter is glued after it
+func hasWordAt(normalized []rune, pos int, word string) bool {
+	return hasPrefixAt(normalized, pos, word) && !isLetterAt(normalized, pos+len([]rune(word)))
+}
+
+func isLetterAt(normalized []rune, pos int) bool {
+	return pos < len(normalized) && unicode.IsLetter(normalized[pos])
+}
+
+// hasPrefixAt reports whether normalized[pos:] starts with word
+func hasPrefixAt(normalized []rune, pos int, word string) bool {
+	runes := []rune(word)
+	if pos < 0 || pos+len(runes) > len(normalized) {
+		return false
+	}
+	return string(normalized[pos:pos+len(runes)]) == word
+}
+
+// skipSpaces returns the index of the first rune from pos that is not a space
+func skipSpaces(normalized []rune, pos int) int {
+	for pos < len(normalized) && unicode.IsSpace(normalized[pos]) {
+		pos++
+	}
+	return pos
+}
+
+// skipMonthSeparator skips the spaces or the half space between a month name and "ماه"
+func skipMonthSeparator(normalized []rune, pos int) int {
+	if pos < len(normalized) && string(normalized[pos]) == halfSpace {
+		return pos + 1
+	}
+	return skipSpaces(normalized, pos)
+}
+
+// overlapsDate reports whether the runes from start to end are part of a date already found
+func overlapsDate(dates []DetectedDate, start, end int) bool {
+	for _, date := range dates {
+		if start <= date.EndIndex && date.StartIndex <= end {
+			return true
+		}
+	}
+	return false
+}
+
+func buildMonthNameMap() map[string]int {
+	normalizer := internal.NewNormalizer(options.DefaultOptions)
+	names := make(map[string]int, len(jalaliMonthNames)+1)
+	for i, name := range jalaliMonthNames {
+		names[string(normalizer.NormalizeCharacters(name))] = i + 1
+	}
+	names[string(normalizer.NormalizeCharacters("امرداد"))] = 5 // the older name of مرداد
+	return names
+}
+
+func isASCIIDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func atoi(s string) int {
+	n := 0
+	for _, r := range s {
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
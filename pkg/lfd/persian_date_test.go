@@ -0,0 +1,112 @@
+package lfd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPersianDateDetector_DetectDates(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		dates []DetectedDate
+	}{
+		{
+			name:  "digits_and_month_name",
+			input: "جلسه ۱۵ مهر ۱۴۰۲ برگزار شد",
+			dates: []DetectedDate{{Date: JalaliDate{Year: 1402, Month: 7, Day: 15}, StartIndex: 5, EndIndex: 15, Text: "۱۵ مهر ۱۴۰۲"}},
+		},
+		{
+			name:  "numeric_year_first",
+			input: "تاریخ ۱۴۰۲/۰۷/۱۵",
+			dates: []DetectedDate{{Date: JalaliDate{Year: 1402, Month: 7, Day: 15}, StartIndex: 6, EndIndex: 15, Text: "۱۴۰۲/۰۷/۱۵"}},
+		},
+		{
+			name:  "numeric_day_first",
+			input: "15-7-1402",
+			dates: []DetectedDate{{Date: JalaliDate{Year: 1402, Month: 7, Day: 15}, StartIndex: 0, EndIndex: 8, Text: "15-7-1402"}},
+		},
+		{
+			name:  "spelled_day_and_glued_month_word",
+			input: "پانزدهم مهرماه",
+			dates: []DetectedDate{{Date: JalaliDate{Month: 7, Day: 15}, StartIndex: 0, EndIndex: 13, Text: "پانزدهم مهرماه"}},
+		},
+		{
+			name:  "spelled_day_and_year",
+			input: "بیست و یکم اسفند ماه سال هزار و چهارصد و دو",
+			dates: []DetectedDate{{Date: JalaliDate{Year: 1402, Month: 12, Day: 21}, StartIndex: 0, EndIndex: 42, Text: "بیست و یکم اسفند ماه سال هزار و چهارصد و دو"}},
+		},
+		{
+			name:  "month_with_alef_madda",
+			input: "اول آبان",
+			dates: []DetectedDate{{Date: JalaliDate{Month: 8, Day: 1}, StartIndex: 0, EndIndex: 7, Text: "اول آبان"}},
+		},
+		{
+			name:  "several_dates",
+			input: "از ۱ فروردین تا 1403/01/13",
+			dates: []DetectedDate{
+				{Date: JalaliDate{Month: 1, Day: 1}, StartIndex: 3, EndIndex: 11, Text: "۱ فروردین"},
+				{Date: JalaliDate{Year: 1403, Month: 1, Day: 13}, StartIndex: 16, EndIndex: 25, Text: "1403/01/13"},
+			},
+		},
+		{
+			name:  "small_number_after_month_is_not_a_year",
+			input: "۵ دی ۲ نفر",
+			dates: []DetectedDate{{Date: JalaliDate{Month: 10, Day: 5}, StartIndex: 0, EndIndex: 3, Text: "۵ دی"}},
+		},
+		{name: "word_starting_with_month_name", input: "۳ مهربان", dates: []DetectedDate{}},
+		{name: "day_out_of_month", input: "۳۱ مهر", dates: []DetectedDate{}},
+		{name: "esfand_of_a_common_year", input: "۳۰ اسفند ۱۴۰۲", dates: []DetectedDate{}},
+		{name: "gregorian_numeric_date", input: "2023/10/07", dates: []DetectedDate{}},
+		{name: "longer_numeric_run", input: "1402/07/15/3", dates: []DetectedDate{}},
+		{name: "empty", input: "", dates: []DetectedDate{}},
+	}
+
+	detector := &PersianDateDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detector.DetectDates(tt.input); !reflect.DeepEqual(got, tt.dates) {
+				t.Errorf("DetectDates() = %v, want %v", got, tt.dates)
+			}
+		})
+	}
+}
+
+func TestJalaliDate_Time(t *testing.T) {
+	tests := []struct {
+		name      string
+		jalali    JalaliDate
+		gregorian time.Time
+	}{
+		{name: "mehr", jalali: JalaliDate{Year: 1402, Month: 7, Day: 15}, gregorian: time.Date(2023, 10, 7, 0, 0, 0, 0, time.UTC)},
+		{name: "nowruz", jalali: JalaliDate{Year: 1403, Month: 1, Day: 1}, gregorian: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{name: "last_day_of_common_year", jalali: JalaliDate{Year: 1402, Month: 12, Day: 29}, gregorian: time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC)},
+		{name: "last_day_of_leap_year", jalali: JalaliDate{Year: 1403, Month: 12, Day: 30}, gregorian: time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{name: "bahman", jalali: JalaliDate{Year: 1357, Month: 11, Day: 22}, gregorian: time.Date(1979, 2, 11, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jalali.Time(time.UTC); !got.Equal(tt.gregorian) {
+				t.Errorf("Time() = %v, want %v", got, tt.gregorian)
+			}
+			if got := JalaliFromTime(tt.gregorian); got != tt.jalali {
+				t.Errorf("JalaliFromTime() = %v, want %v", got, tt.jalali)
+			}
+		})
+	}
+
+	if got := (JalaliDate{Year: 1402, Month: 12, Day: 30}).Time(time.UTC); !got.IsZero() {
+		t.Errorf("Time() of an invalid date = %v, want the zero time", got)
+	}
+}
+
+func TestJalaliFromTime_RoundTrip(t *testing.T) {
+	day := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	for end := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC); day.Before(end); day = day.AddDate(0, 0, 1) {
+		date := JalaliFromTime(day)
+		if !date.IsValid() || !date.Time(time.UTC).Equal(day) {
+			t.Fatalf("JalaliFromTime(%v) = %v, which converts back to %v", day, date, date.Time(time.UTC))
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package lfd
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	decimalPointWord     = "ممیز" // as in "سه ممیز پنج"
+	persianDecimalPoint  = '٫'
+	fractionOrdinalAffix = "م" // only the short ordinal form is a denominator, "یک چهارم" but not "یک چهارمین"
+)
+
+// fractionWordMap maps words that are fractions on their own
+var fractionWordMap = map[string]*big.Rat{
+	"نیم": big.NewRat(1, 2),
+	"ربع": big.NewRat(1, 4),
+}
+
+// withValue sets the exact value of the match, keeping the integer part in value.
+// It fails when the value does not fit in int64.
+func (m numberMatch) withValue(r *big.Rat) (numberMatch, bool) {
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return numberMatch{}, false
+	}
+	m.value = new(big.Int).Quo(r.Num(), r.Denom()).Int64()
+	m.fraction = nil
+	if !r.IsInt() {
+		m.fraction = r
+	}
+	return m, true
+}
+
+// withFraction is withValue for values that are known to fit
+func (m numberMatch) withFraction(r *big.Rat) numberMatch {
+	m, _ = m.withValue(r)
+	return m
+}
+
+// rat returns the exact value of the match
+func (m numberMatch) rat() *big.Rat {
+	if m.fraction != nil {
+		return new(big.Rat).Set(m.fraction)
+	}
+	return new(big.Rat).SetInt64(m.value)
+}
+
+// parseDecimalDigits parses digits with a decimal point, as in "2.5" or "۲٫۵"
+func parseDecimalDigits(s string) (*big.Rat, bool) {
+	intPart, fracPart, found := strings.Cut(strings.ReplaceAll(s, string(persianDecimalPoint), "."), ".")
+	if !found || !isNumeric(intPart) || !isNumeric(fracPart) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(intPart + "." + fracPart)
+}
+
+// parseFractionTail extends a whole number with the fraction that follows it:
+// "سه ممیز پنج", "دو و نیم", "دو میلیون و نیم", "یک و سه چهارم" or "سه چهارم".
+func parseFractionTail(match numberMatch, tokens []Token, index *int) (numberMatch, bool) {
+	next := skipWhitespace(tokens, *index)
+	if next >= len(tokens) {
+		return match, true
+	}
+
+	word := tokens[next].Value
+	switch {
+	case word == decimalPointWord:
+		pos := skipWhitespace(tokens, next)
+		if pos >= len(tokens) {
+			return match, true
+		}
+		fraction, ok := parseDecimalPart(tokens, &pos)
+		if !ok {
+			return match, true
+		}
+		extended, ok := match.withValue(new(big.Rat).Add(match.rat(), fraction))
+		if !ok {
+			return match, true
+		}
+		extended.end = tokens[pos].EndIndex
+		*index = pos
+		return applyTrailingScale(extended, tokens, index)
+
+	case word == "و":
+		pos := skipWhitespace(tokens, next)
+		if pos >= len(tokens) {
+			return match, true
+		}
+		fraction, ok := parseFractionPhrase(tokens, &pos)
+		if !ok {
+			return match, true
+		}
+		// "دو میلیون و نیم" is half a million more
+		if match.lastScale > 0 {
+			fraction.Mul(fraction, new(big.Rat).SetInt64(match.lastScale))
+		}
+		extended, ok := match.withValue(new(big.Rat).Add(match.rat(), fraction))
+		if !ok {
+			return match, true
+		}
+		extended.end = tokens[pos].EndIndex
+		*index = pos
+		return applyTrailingScale(extended, tokens, index)
+
+	case match.lastScale == 0 && match.start == tokens[*index].StartIndex && lettersRegex.MatchString(tokens[*index].Value):
+		// A single number word followed by a denominator, as in "سه چهارم" or "یک ربع"
+		denominator, last, ok := parseDenominatorAt(tokens, next)
+		if !ok || (word != "ربع" && match.value >= denominator) || match.value < 1 {
+			return match, true
+		}
+		extended := match.withFraction(big.NewRat(match.value, denominator))
+		extended.end = tokens[last].EndIndex
+		*index = last
+		return applyTrailingScale(extended, tokens, index)
+	}
+
+	return match, true
+}
+
+// parseFractionPhrase parses a fraction after "و": "نیم", "ربع" or a numerator and a denominator as in "سه چهارم"
+func parseFractionPhrase(tokens []Token, index *int) (*big.Rat, bool) {
+	word := tokens[*index].Value
+	if val, ok := fractionWordMap[word]; ok {
+		return new(big.Rat).Set(val), true
+	}
+
+	numerator, ok := persianNumberMap[word]
+	if !ok || numerator < 1 {
+		return nil, false
+	}
+	next := skipWhitespace(tokens, *index)
+	if next >= len(tokens) {
+		return nil, false
+	}
+	denominator, last, ok := parseDenominatorAt(tokens, next)
+	if !ok || (tokens[next].Value != "ربع" && numerator >= denominator) {
+		return nil, false
+	}
+	*index = last
+	return big.NewRat(numerator, denominator), true
+}
+
+// parseDenominatorAt parses the denominator of a fraction at tokens[pos], also when its ordinal suffix
+// is a separate token as in "هفت سی‌ام", and returns the index of its last token
+func parseDenominatorAt(tokens []Token, pos int) (int64, int, bool) {
+	if val, ok := parseDenominator(tokens[pos].Value); ok {
+		return val, pos, true
+	}
+	if val, ok := persianNumberMap[tokens[pos].Value]; ok && val > 1 {
+		if last, ok := ordinalSuffixAt(tokens, pos); ok {
+			return val, last, true
+		}
+	}
+	return 0, 0, false
+}
+
+// parseDenominator parses the denominator of a fraction, "چهارم" in "سه چهارم" or "ربع" in "سه ربع"
+func parseDenominator(word string) (int64, bool) {
+	if word == "ربع" {
+		return 4, true
+	}
+	if !strings.HasSuffix(word, fractionOrdinalAffix) {
+		return 0, false
+	}
+	if val, ok := ordinalNumberMap[word]; ok && val > 1 {
+		return val, true
+	}
+	val, ok := parseOrdinalWithSuffix(word)
+	return val, ok && val > 1
+}
+
+// parseDecimalPart parses the digits after "ممیز", either as digits that keep their leading zeros or as a number word.
+// A number word may be followed by its power of ten, as in "دو ممیز پنج دهم" or "سه ممیز بیست و پنج صدم".
+func parseDecimalPart(tokens []Token, index *int) (*big.Rat, bool) {
+	token := tokens[*index]
+	digits := token.Value
+	if !isNumeric(digits) {
+		match, ok := parseNumberWordWithPositions(token, tokens, index)
+		if !ok {
+			return nil, false
+		}
+		if match.ordinal {
+			// the digits took their power of ten as a scale, as in "دوازده ممیز صد و بیست و پنج هزارم"
+			numerator := match.value / max(match.lastScale, 1)
+			if match.lastScale == 0 || match.value%match.lastScale != 0 || numerator >= match.lastScale {
+				return nil, false
+			}
+			return big.NewRat(numerator, match.lastScale), true
+		}
+		if denominator, ok := parseDecimalDenominator(tokens, index); ok && match.value < denominator {
+			return big.NewRat(match.value, denominator), true
+		}
+		digits = strconv.FormatInt(match.value, 10)
+	}
+	return new(big.Rat).SetString("0." + digits)
+}
+
+// parseDecimalDenominator parses an ordinal power of ten after tokens[*index], as in "دهم", "صدم" or "ده هزارم"
+func parseDecimalDenominator(tokens []Token, index *int) (int64, bool) {
+	next := skipWhitespace(tokens, *index)
+	if next >= len(tokens) {
+		return 0, false
+	}
+	pos := next
+	match, ok := parseNumberWordWithPositions(tokens[next], tokens, &pos)
+	if !ok || !match.ordinal || match.value < 10 {
+		return 0, false
+	}
+	for rest := match.value; rest > 1; rest /= 10 {
+		if rest%10 != 0 {
+			return 0, false
+		}
+	}
+	*index = pos
+	return match.value, true
+}
+
+// applyTrailingScale multiplies a fractional number by the scale that follows it, as in "دو و نیم میلیون"
+func applyTrailingScale(match numberMatch, tokens []Token, index *int) (numberMatch, bool) {
+	if match.lastScale > 0 {
+		return match, true
+	}
+	next := skipWhitespace(tokens, *index)
+	if next >= len(tokens) {
+		return match, true
+	}
+	exponent, isScale := scaleNumberMap[tokens[next].Value]
+	if !isScale {
+		return match, true
+	}
+	scale, ok := pow1000(exponent)
+	if !ok {
+		return numberMatch{}, false
+	}
+
+	extended, ok := match.withValue(new(big.Rat).Mul(match.rat(), new(big.Rat).SetInt64(scale)))
+	*index = next
+	if !ok {
+		return numberMatch{}, false
+	}
+	extended.lastScale = scale
+	extended.end = tokens[next].EndIndex
+	return extended, true
+}
+
+// newRational converts the fraction of a match to a Rational, the zero Rational for whole numbers
+func newRational(r *big.Rat) Rational {
+	if r == nil {
+		return Rational{}
+	}
+	return Rational{Numerator: r.Num().Int64(), Denominator: r.Denom().Int64()}
+}
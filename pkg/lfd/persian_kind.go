@@ -0,0 +1,122 @@
+package lfd
+
+import (
+	"math/big"
+	"unicode"
+	"unicode/utf8"
+)
+
+const negativeWord = "منفی" // as in "منفی پنج"
+
+// negate returns the match with the opposite sign
+func (m numberMatch) negate() numberMatch {
+	m.value = -m.value
+	if m.fraction != nil {
+		m.fraction = new(big.Rat).Neg(m.fraction)
+	}
+	return m
+}
+
+// parseNegativeWithPositions parses a number after "منفی", as in "منفی سه" or "منفی ۲٫۵".
+// Ordinals are never negative, "منفی سوم" is not a number.
+func parseNegativeWithPositions(token Token, tokens []Token, index *int, fractions bool) (numberMatch, bool) {
+	next := skipWhitespace(tokens, *index)
+	if next >= len(tokens) {
+		return numberMatch{}, false
+	}
+	pos := next
+	match, ok := parseTokenWithPositions(tokens[next], tokens, &pos, fractions)
+	if !ok || match.ordinal {
+		return numberMatch{}, false
+	}
+	*index = pos
+	match.start = token.StartIndex
+	return match.negate(), true
+}
+
+// describeNumbers applies a minus sign written right before digits, as in "-5",
+// and sets the kind and the surface text of every number found in input
+func describeNumbers(input []rune, numbers []DetectedNumber) []DetectedNumber {
+	for i, number := range numbers {
+		if hasMinusSign(input, number) {
+			number.Number = -number.Number
+			number.Fraction.Numerator = -number.Fraction.Numerator
+			number.StartIndex--
+		}
+		number.Text = string(input[number.StartIndex : number.EndIndex+1])
+		number.Kind = numberKind(number)
+		numbers[i] = number
+	}
+	return numbers
+}
+
+// withoutDescription clears what describeNumbers and the ordinal flag add to the numbers,
+// for detectors that did not ask for a description
+func withoutDescription(numbers []DetectedNumber) []DetectedNumber {
+	for i := range numbers {
+		numbers[i].Ordinal = false
+		numbers[i].Kind = ""
+		numbers[i].Text = ""
+	}
+	return numbers
+}
+
+// hasMinusSign reports whether a positive number written in digits follows a minus sign that starts a word,
+// so ranges such as "۱۰-۲۰" stay positive
+func hasMinusSign(input []rune, number DetectedNumber) bool {
+	start := number.StartIndex
+	if number.Number < 0 || number.Ordinal || start == 0 || !unicode.IsDigit(input[start]) {
+		return false
+	}
+	if sign := input[start-1]; sign != '-' && sign != '−' {
+		return false
+	}
+	return start == 1 || unicode.IsSpace(input[start-2])
+}
+
+func numberKind(number DetectedNumber) Kind {
+	switch {
+	case number.Number < 0 || number.Fraction.Numerator < 0:
+		return KindNegative
+	case number.IsFraction():
+		return KindFraction
+	case number.Ordinal:
+		return KindOrdinal
+	}
+
+	hasDigit, hasLetter := false, false
+	for _, r := range number.Text {
+		hasDigit = hasDigit || unicode.IsDigit(r)
+		hasLetter = hasLetter || unicode.IsLetter(r)
+	}
+	switch {
+	case hasDigit && hasLetter:
+		return KindMixed
+	case hasLetter:
+		return KindWords
+	case isPhoneLike(number):
+		return KindPhone
+	case isYearLike(number):
+		return KindYear
+	}
+	return KindDigits
+}
+
+// isPhoneLike reports whether the digits look like an Iranian phone number,
+// "09121234567", "02188776655" or "989121234567"
+func isPhoneLike(number DetectedNumber) bool {
+	length := utf8.RuneCountInString(number.Text)
+	first, _ := utf8.DecodeRuneInString(number.Text)
+	if (first == '0' || first == '۰' || first == '٠') && (length == 10 || length == 11) {
+		return true
+	}
+	return length == 12 && number.Number/10_000_000_000 == 98
+}
+
+// isYearLike reports whether the digits look like a Jalali year such as "۱۴۰۲" or a Gregorian year such as "2024"
+func isYearLike(number DetectedNumber) bool {
+	if utf8.RuneCountInString(number.Text) != 4 {
+		return false
+	}
+	return (number.Number >= 1300 && number.Number < 1500) || (number.Number >= 1900 && number.Number < 2100)
+}
@@ -1,12 +1,17 @@
 package lfd
 
 import (
+	"math"
+	"math/big"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/numword"
 	"github.com/snapp-incubator/seperno/pkg/options"
 )
 
@@ -21,19 +26,26 @@ var (
 		"هفتاد": 70, "هشتاد": 80, "نود": 90,
 		"صد": 100, "یکصد": 100, "دویست": 200, "سیصد": 300, "چهارصد": 400, "چارصد": 400,
 		"پانصد": 500, "پونصد": 500, "ششصد": 600, "شونصد": 600, "هفتصد": 700, "هشتصد": 800, "نهصد": 900,
-		"هزار": 1000,
 	}
 
+	// scaleNumberMap maps scale words (هزار, میلیون, ...) to their power of 1000, built from numword.Scales
+	scaleNumberMap = buildScaleNumberMap()
+
 	ordinalNumberMap = map[string]int64{
-		"اول": 1, "دوم": 2, "سوم": 3,
+		"اول": 1, "نخست": 1, "دوم": 2, "سوم": 3,
 	}
 
-	ordinalSuffixes = []string{"مین", "ام", "وم", "م", "ین"}
-	multipliers     = map[string]int64{"صد": 100, "هزار": 1000}
+	ordinalSuffixes = []string{"امین", "مین", "ام", "ین", "م"}
+
+	// conjunctionRegexes split "و" glued to number words, compiled once from the word list
+	conjunctionRegexes = buildConjunctionRegexes()
 
 	// Compiled regexes
-	lettersRegex = regexp.MustCompile(`^[\p{L}]+$`)              // Matches strings containing Unicode letters (for Persian word validation)
-	tokenRegex   = regexp.MustCompile(`([\p{L}]+|[\p{N}]+|\s+)`) // Splits text into tokens: letter sequences, number sequences, or whitespace
+	lettersRegex = regexp.MustCompile(`^[\p{L}]+$`) // Matches strings containing Unicode letters (for Persian word validation)
+	// Splits text into tokens: letter sequences, number sequences with optional thousands separators and decimal part, or whitespace (including half spaces)
+	tokenRegex = regexp.MustCompile(`([\p{L}]+|[\p{N}]{1,3}(?:،[\p{N}]{3})+(?:[.٫][\p{N}]+)?|[\p{N}]+(?:[.٫][\p{N}]+)?|[\s\p{Z}\p{Cf}]+)`)
+	// Like tokenRegex without the decimal part, so "۲٫۵" is two numbers when fractions are not detected
+	integerTokenRegex = regexp.MustCompile(`([\p{L}]+|[\p{N}]{1,3}(?:،[\p{N}]{3})+|[\p{N}]+|[\s\p{Z}\p{Cf}]+)`)
 )
 
 type Token struct {
@@ -42,7 +54,16 @@ type Token struct {
 	EndIndex   int
 }
 
-type PersianNumberDetector struct{}
+type PersianNumberDetector struct {
+	// Colloquial enables informal spoken forms such as "یه", "شیش" or "هیژده",
+	// and numbers glued to the "تا" counter or "تومن", as in "دوتا" or "صدتومن"
+	Colloquial bool
+	// Fractions enables decimal and fractional numbers such as "دو و نیم", "سه ممیز پنج", "یک چهارم" or "۲٫۵".
+	// Their exact value is in DetectedNumber.Fraction and Number holds the integer part.
+	Fractions bool
+	// Describe sets the Ordinal, Kind and Text fields of the detected numbers, which are left empty otherwise
+	Describe bool
+}
 
 // DetectNumbers converts Persian number words to digits
 func (f *PersianNumberDetector) DetectNumbers(text string) []DetectedNumber {
@@ -54,12 +75,28 @@ func (f *PersianNumberDetector) DetectNumbers(text string) []DetectedNumber {
 	normalizedCharacters := normalizer.NormalizeCharacters(text)
 	preprocessed, addedSpacesPSumArray := preprocessConjunctions(string(normalizedCharacters))
 	tokens := tokenizeWithPositions(preprocessed)
+	if !f.Fractions {
+		tokens = tokenizeIntegers(preprocessed)
+	}
+	if f.Colloquial {
+		tokens = colloquialTokens(tokens)
+	}
 
-	return processTokensToNumbers(tokens, addedSpacesPSumArray)
+	numbers := describeNumbers([]rune(text), processTokens(tokens, addedSpacesPSumArray, f.Fractions))
+	if !f.Describe {
+		return withoutDescription(numbers)
+	}
+	return numbers
 }
 
-// processTokensToNumbers processes tokens and converts detected numbers to DetectedNumber structs
+// processTokensToNumbers processes tokens and converts detected whole numbers to DetectedNumber structs
 func processTokensToNumbers(tokens []Token, addedSpacesPSumArray []int) []DetectedNumber {
+	return withoutDescription(processTokens(tokens, addedSpacesPSumArray, false))
+}
+
+// processTokens is processTokensToNumbers that also reads fractions when fractions is set
+// and tells ordinals apart from cardinals
+func processTokens(tokens []Token, addedSpacesPSumArray []int, fractions bool) []DetectedNumber {
 	result := make([]DetectedNumber, 0)
 	for i := 0; i < len(tokens); i++ {
 		token := tokens[i]
@@ -68,11 +105,17 @@ func processTokensToNumbers(tokens []Token, addedSpacesPSumArray []int) []Detect
 			continue
 		}
 
-		if _, numVal, startIdx, endIdx, isNumber := parseTokenWithPositions(token, tokens, &i); isNumber {
+		parse := parseTokenWithPositions
+		if token.Value == negativeWord {
+			parse = parseNegativeWithPositions
+		}
+		if match, isNumber := parse(token, tokens, &i, fractions); isNumber {
 			result = append(result, DetectedNumber{
-				Number:     numVal,
-				StartIndex: startIdx - addedSpacesPSumArray[startIdx],
-				EndIndex:   endIdx - addedSpacesPSumArray[endIdx],
+				Number:     match.value,
+				StartIndex: match.start - addedSpacesPSumArray[match.start],
+				EndIndex:   match.end - addedSpacesPSumArray[match.end],
+				Fraction:   newRational(match.fraction),
+				Ordinal:    match.ordinal,
 			})
 		}
 	}
@@ -80,17 +123,26 @@ func processTokensToNumbers(tokens []Token, addedSpacesPSumArray []int) []Detect
 }
 
 func tokenizeWithPositions(input string) []Token {
-	matches := tokenRegex.FindAllStringSubmatch(input, -1)
-	indexes := tokenRegex.FindAllStringIndex(input, -1)
+	return tokenizeMatches(input, tokenRegex)
+}
 
-	tokens := make([]Token, len(matches))
-	for i, match := range matches {
-		// Convert byte indices to rune indices
-		startRuneIndex := utf8.RuneCountInString(input[:indexes[i][0]])
-		endRuneIndex := utf8.RuneCountInString(input[:indexes[i][1]]) - 1
+// tokenizeIntegers is tokenizeWithPositions that leaves the decimal separator between digits out of the tokens
+func tokenizeIntegers(input string) []Token {
+	return tokenizeMatches(input, integerTokenRegex)
+}
+
+func tokenizeMatches(input string, pattern *regexp.Regexp) []Token {
+	indexes := pattern.FindAllStringIndex(input, -1)
+
+	tokens := make([]Token, len(indexes))
+	bytePos, runePos := 0, 0 // convert byte indices to rune indices, counting only the bytes since the last token
+	for i, index := range indexes {
+		startRuneIndex := runePos + utf8.RuneCountInString(input[bytePos:index[0]])
+		endRuneIndex := startRuneIndex + utf8.RuneCountInString(input[index[0]:index[1]]) - 1
+		bytePos, runePos = index[1], endRuneIndex+1
 
 		tokens[i] = Token{
-			Value:      match[0],
+			Value:      input[index[0]:index[1]],
 			StartIndex: startRuneIndex,
 			EndIndex:   endRuneIndex,
 		}
@@ -98,130 +150,384 @@ func tokenizeWithPositions(input string) []Token {
 	return tokens
 }
 
-// parseTokenWithPositions processes a single token and returns its numeric representation with positions
-func parseTokenWithPositions(token Token, tokens []Token, index *int) (string, int64, int, int, bool) {
+// numberMatch is a number found in the tokens, with positions in the preprocessed text
+type numberMatch struct {
+	value     int64
+	fraction  *big.Rat // exact value of a fractional number, nil for integers
+	lastScale int64    // the last scale word of the number, zero if it has none
+	ordinal   bool
+	start     int
+	end       int
+}
+
+// parseTokenWithPositions processes a single token and returns the number starting at it with its positions.
+// Fractions are only read when fractions is set.
+func parseTokenWithPositions(token Token, tokens []Token, index *int, fractions bool) (numberMatch, bool) {
 	trimmed := strings.TrimSpace(token.Value)
 	if trimmed == "" {
-		return token.Value, 0, 0, 0, false
+		return numberMatch{}, false
+	}
+
+	// Thousands separators only appear in digit tokens, as in "۲۵٬۰۰۰", and are normalized to "،"
+	trimmed = strings.ReplaceAll(trimmed, thousandsSeparator, "")
+
+	// Handle decimal digits, as in "۲٫۵" or "2.5 میلیون"
+	if val, ok := parseDecimalDigits(trimmed); ok && fractions {
+		match, ok := numberMatch{start: token.StartIndex, end: token.EndIndex}.withValue(val)
+		if !ok {
+			return numberMatch{}, false
+		}
+		return applyTrailingScale(match, tokens, index)
 	}
 
-	// Handle existing digits
+	// Handle existing digits, alone or followed by number words as in "۲ میلیون" or "3 هزار و 500"
 	if val, ok := parseDigits(trimmed); ok {
-		return strconv.FormatInt(val, 10), val, token.StartIndex, token.EndIndex, true
+		if !isGroupDigits(trimmed) {
+			return numberMatch{value: val, start: token.StartIndex, end: token.EndIndex}, true
+		}
+		match, ok := parseCompoundNumberWithPositions(val, token, tokens, index)
+		if !ok || !fractions {
+			return match, ok
+		}
+		return parseFractionTail(match, tokens, index)
 	}
 
-	// Handle ordinals and compound numbers
-	if val, endIdx, ok := parseNumberWordWithPositions(token, tokens, index); ok {
-		return strconv.FormatInt(val, 10), val, token.StartIndex, endIdx, true
+	// Handle fractions on their own, as in "نیم ساعت" or "نیم میلیون"
+	if val, ok := fractionWordMap[trimmed]; ok && fractions {
+		match := numberMatch{start: token.StartIndex, end: token.EndIndex}
+		return applyTrailingScale(match.withFraction(val), tokens, index)
 	}
 
-	return token.Value, 0, 0, 0, false
+	// Handle ordinals and compound numbers
+	match, ok := parseNumberWordWithPositions(token, tokens, index)
+	if !ok {
+		return numberMatch{}, false
+	}
+	if match.ordinal || !fractions {
+		return match, true
+	}
+	return parseFractionTail(match, tokens, index)
 }
 
-func parseNumberWordWithPositions(token Token, tokens []Token, index *int) (int64, int, bool) {
+func parseNumberWordWithPositions(token Token, tokens []Token, index *int) (numberMatch, bool) {
 	word := token.Value
+	single := func(val int64, ordinal bool) (numberMatch, bool) {
+		return numberMatch{value: val, ordinal: ordinal, start: token.StartIndex, end: token.EndIndex}, true
+	}
+
+	// Zero never starts a compound number, "صفر نهصد" is two numbers
+	if word == "صفر" {
+		return single(0, false)
+	}
 
 	// Try direct lookup
 	if val, exists := persianNumberMap[word]; exists {
 		return parseCompoundNumberWithPositions(val, token, tokens, index)
 	}
 
+	// A number may start with a bare scale word, as in "هزار و دویست"
+	if _, exists := scaleNumberMap[word]; exists {
+		return parseCompoundNumberWithPositions(0, token, tokens, index)
+	}
+
 	if val, exists := ordinalNumberMap[word]; exists {
-		return val, token.EndIndex, true
+		return single(val, true)
 	}
 
 	// Try ordinal with suffix
 	if val, ok := parseOrdinalWithSuffix(word); ok {
-		return val, token.EndIndex, true
+		return single(val, true)
 	}
 
-	return 0, 0, false
+	return numberMatch{}, false
 }
 
-func parseCompoundNumberWithPositions(initial int64, startToken Token, tokens []Token, index *int) (int64, int, bool) {
-	total := initial
+// parseCompoundNumberWithPositions reads a number made of several words starting at tokens[*index],
+// such as "سیصد و بیست هزار و چهل" or "دو میلیون پانصد هزار".
+// When the number does not fit in int64 the whole phrase is skipped and no number is reported.
+// Digits take part in the number as a group, but two digit groups are never joined without a word between them.
+func parseCompoundNumberWithPositions(initial int64, startToken Token, tokens []Token, index *int) (numberMatch, bool) {
+	acc := numberAccumulator{current: initial, last: initial, hasWord: !isNumeric(startToken.Value), digitGroup: isNumeric(startToken.Value)}
+	if exponent, isScale := scaleNumberMap[startToken.Value]; isScale {
+		acc = numberAccumulator{}
+		acc.applyScale(exponent)
+	}
+
 	pos := *index
 	endIdx := startToken.EndIndex
+	ordinal := false
+
+	// The number as it was after its last scale. A group followed by a scale that cannot apply to it,
+	// as "دو" in "یک میلیون و دو میلیون", starts a new number, so the number goes back to its last scale.
+	scaled, scaledPos, scaledEnd := acc, pos, endIdx
+	endsAtScale := func() {
+		if !acc.overflow && acc.lastScale > 0 && acc.current > 0 {
+			acc, pos, endIdx = scaled, scaledPos, scaledEnd
+		}
+	}
 
-	for {
-		next := pos + 1
-
-		// Skip whitespace
-		for next < len(tokens) && isWhitespace(tokens[next].Value) {
-			next++
+	for !acc.overflow {
+		// A separate ordinal suffix ends the number, as in "سی‌ام" or "۲۰مین"
+		if next, ok := ordinalSuffixAt(tokens, pos); ok {
+			pos = next
+			endIdx = tokens[next].EndIndex
+			ordinal = true
+			break
 		}
 
+		next := skipWhitespace(tokens, pos)
 		if next >= len(tokens) {
 			break
 		}
 
 		token := tokens[next]
 
-		// Handle multipliers (هزار، صد)
-		if mult, isMultiplier := multipliers[token.Value]; isMultiplier {
-			total *= mult
+		// An ordinal scale ends the number, as in "دو هزارمین" or "صد و بیست هزارم".
+		// A unit followed by "هزارم" is left to be read as a fraction, as in "دو هزارم".
+		if exponent, isScale := parseOrdinalScale(token.Value); isScale {
+			if pos == *index && acc.current < 10 && !strings.HasSuffix(token.Value, "مین") {
+				break
+			}
+			if !acc.applyScale(exponent) {
+				if acc.overflow {
+					pos = next
+				}
+				endsAtScale()
+				break
+			}
+			pos = next
+			endIdx = token.EndIndex
+			ordinal = true
+			break
+		}
+
+		// Handle scales (هزار، میلیون، ...)
+		if exponent, isScale := scaleNumberMap[token.Value]; isScale {
+			if !acc.applyScale(exponent) {
+				if acc.overflow {
+					pos = next // skip the scale word too, it is part of the number that does not fit
+				}
+				endsAtScale()
+				break
+			}
 			pos = next
 			endIdx = token.EndIndex
+			scaled, scaledPos, scaledEnd = acc, pos, endIdx
 			continue
 		}
 
 		// Handle separated hundreds (یک صد)
-		if token.Value == "صد" {
-			total *= 100
+		if token.Value == "صد" && acc.applyHundred() {
 			pos = next
 			endIdx = token.EndIndex
 			continue
 		}
 
+		// A smaller group may follow a scale without "و", as in "یک هزار دویست" or "۲ میلیون ۵۰۰ هزار".
+		// Digits only follow a scale that follows digits, "بیست و پنج هزار 12" is two numbers.
+		if acc.afterScale {
+			digits := !lettersRegex.MatchString(token.Value)
+			if val, ok := parseGroup(token.Value); ok && (!digits || acc.digitScale) && acc.add(val) {
+				acc.digitGroup = digits
+				pos = next
+				endIdx = token.EndIndex
+				continue
+			}
+			if val, ok := parseNextNumber(token.Value); ok && isOrdinalWord(token.Value) && acc.add(val) {
+				pos = next
+				endIdx = token.EndIndex
+				ordinal = true
+				break
+			}
+		}
+
 		// Expect conjunction "و"
 		if token.Value != "و" {
 			break
 		}
 
-		next++ // Skip "و"
-
-		// Skip whitespace after "و"
-		for next < len(tokens) && isWhitespace(tokens[next].Value) {
-			next++
-		}
-
-		if next >= len(tokens) || !lettersRegex.MatchString(tokens[next].Value) {
+		next = skipWhitespace(tokens, next) // Skip whitespace after "و"
+		if next >= len(tokens) {
 			break
 		}
 
-		// Parse next number
-		nextVal, ok := parseNextNumber(tokens[next].Value)
-		if !ok {
+		// Parse next number, digits only join a number that already has a word, "3 و 4" is two numbers
+		var nextVal int64
+		var ok bool
+		if lettersRegex.MatchString(tokens[next].Value) {
+			nextVal, ok = parseNextNumber(tokens[next].Value)
+		} else if acc.hasWord {
+			nextVal, ok = parseGroup(tokens[next].Value)
+		}
+		if !ok || !acc.add(nextVal) {
 			break
 		}
 
-		total += nextVal
 		pos = next
 		endIdx = tokens[next].EndIndex
+		acc.digitGroup = !lettersRegex.MatchString(tokens[next].Value)
+		if !acc.digitGroup {
+			acc.hasWord = true
+		}
+
+		// An ordinal ends the number, as in "بیست و پنجم"
+		if isOrdinalWord(tokens[next].Value) {
+			ordinal = true
+			break
+		}
 	}
 
 	*index = pos
-	return total, endIdx, true
+	if acc.overflow {
+		return numberMatch{}, false
+	}
+	return numberMatch{
+		value:     acc.total + acc.current,
+		lastScale: acc.lastScale,
+		ordinal:   ordinal,
+		start:     startToken.StartIndex,
+		end:       endIdx,
+	}, true
 }
 
-// Keep the rest of the functions unchanged
-func preprocessConjunctions(input string) (string, []int) {
-	numberWords := getNumberWordList()
-	pattern := `(` + strings.Join(numberWords, "|") + `)`
+// skipWhitespace returns the index of the first non-whitespace token after tokens[pos].
+// It returns len(tokens) when there is none or when the next token does not directly follow,
+// so numbers are never joined across punctuation.
+func skipWhitespace(tokens []Token, pos int) int {
+	next := pos + 1
+	for next < len(tokens) && isWhitespace(tokens[next].Value) {
+		if tokens[next].StartIndex != tokens[next-1].EndIndex+1 {
+			return len(tokens)
+		}
+		next++
+	}
+	if next < len(tokens) && tokens[next].StartIndex != tokens[next-1].EndIndex+1 {
+		return len(tokens)
+	}
+	return next
+}
+
+// numberAccumulator builds the value of a number word by word.
+// current is the group below 1000 that is still being read, and total holds the groups already closed by a scale word.
+type numberAccumulator struct {
+	total      int64
+	current    int64
+	last       int64 // the last value added to current
+	maxScale   int64 // the largest scale applied so far
+	lastScale  int64 // the last scale applied
+	afterScale bool  // whether the last word was a scale
+	hasWord    bool  // whether the number has a word, not only digits
+	digitGroup bool  // whether current was written with digits
+	digitScale bool  // whether the last scale followed a group written with digits, as in "۲ میلیون"
+	overflow   bool
+}
 
-	replacements := []struct {
-		pattern     string
-		replacement string
-	}{
-		{pattern + `و` + pattern, "$1 و $2"},
-		{pattern + `و`, "$1 و"},
-		{`و` + pattern, "و $1"},
+// applyScale multiplies the current group by 1000^exponent.
+// A larger scale than every previous one multiplies the whole number, as in "هزار میلیارد".
+func (a *numberAccumulator) applyScale(exponent int) bool {
+	scale, ok := pow1000(exponent)
+	if !ok {
+		a.overflow = true
+		return false
+	}
+
+	group := a.current
+	switch {
+	case scale > a.maxScale:
+		if group == 0 && a.total == 0 {
+			group = 1
+		}
+		var sum int64
+		if sum, ok = addInt64(a.total, group); ok {
+			a.total, ok = mulInt64(sum, scale)
+		}
+		a.maxScale = scale
+	case group > 0 && scale < a.lastScale:
+		var value int64
+		if value, ok = mulInt64(group, scale); ok {
+			a.total, ok = addInt64(a.total, value)
+		}
+	default:
+		return false
+	}
+	if !ok {
+		a.overflow = true
+		return false
 	}
 
+	a.current, a.last = 0, 0
+	a.lastScale = scale
+	a.afterScale = true
+	a.digitScale, a.digitGroup = a.digitGroup, false
+	a.hasWord = true
+	return true
+}
+
+// applyHundred turns a unit into hundreds, as in "چهار صد"
+func (a *numberAccumulator) applyHundred() bool {
+	if a.afterScale || a.last < 1 || a.last > 9 || a.current != a.last {
+		return false
+	}
+	a.current *= 100
+	a.last = a.current
+	return true
+}
+
+// add adds value to the current group if it fills a place that is still empty
+func (a *numberAccumulator) add(value int64) bool {
+	if value < 0 || value >= 1000 || (a.afterScale && value >= a.lastScale) {
+		return false
+	}
+
+	switch {
+	case a.current == 0:
+	case a.current%100 == 0:
+		if value >= 100 {
+			return false
+		}
+	case a.current%10 == 0 && a.current%100 >= 20:
+		if value >= 10 {
+			return false
+		}
+	default:
+		return false
+	}
+
+	a.current += value
+	a.last = value
+	a.afterScale = false
+	return true
+}
+
+func pow1000(exponent int) (int64, bool) {
+	result := int64(1)
+	for i := 0; i < exponent; i++ {
+		var ok bool
+		if result, ok = mulInt64(result, 1000); !ok {
+			return 0, false
+		}
+	}
+	return result, true
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a != 0 && b > math.MaxInt64/a {
+		return 0, false
+	}
+	return a * b, true
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	if a > math.MaxInt64-b {
+		return 0, false
+	}
+	return a + b, true
+}
+
+func preprocessConjunctions(input string) (string, []int) {
 	result := input
-	for _, r := range replacements {
-		re := regexp.MustCompile(r.pattern)
-		result = re.ReplaceAllString(result, r.replacement)
+	for _, r := range conjunctionRegexes {
+		result = r.re.ReplaceAllString(result, r.replacement)
 	}
 
 	addedSpaces := make([]int, 0)
@@ -249,21 +555,66 @@ func preprocessConjunctions(input string) (string, []int) {
 	return result, psumArray
 }
 
+type conjunctionRegex struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+func buildConjunctionRegexes() []conjunctionRegex {
+	pattern := `(` + strings.Join(getNumberWordList(), "|") + `)`
+
+	return []conjunctionRegex{
+		{regexp.MustCompile(pattern + `و` + pattern), "$1 و $2"},
+		{regexp.MustCompile(pattern + `و`), "$1 و"},
+		{regexp.MustCompile(`و` + pattern), "و $1"},
+	}
+}
+
+func buildScaleNumberMap() map[string]int {
+	scales := make(map[string]int)
+	for exponent, word := range numword.Scales() {
+		if word != "" {
+			scales[word] = exponent
+		}
+	}
+	return scales
+}
+
+// getNumberWordList returns every number word, longest first so the regex alternation is deterministic
 func getNumberWordList() []string {
-	words := make([]string, 0, len(persianNumberMap)+len(ordinalNumberMap))
+	words := make([]string, 0, len(persianNumberMap)+len(scaleNumberMap)+len(ordinalNumberMap))
 
 	for word := range persianNumberMap {
-		words = append(words, regexp.QuoteMeta(word))
+		words = append(words, word)
+	}
+	for word := range scaleNumberMap {
+		words = append(words, word)
 	}
 	for word := range ordinalNumberMap {
-		words = append(words, regexp.QuoteMeta(word))
+		words = append(words, word)
 	}
 
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
 	return words
 }
 
+const (
+	halfSpace          = "\u200c"
+	thousandsSeparator = "،" // "٬" and "," after character normalization
+)
+
 func isWhitespace(token string) bool {
-	return strings.TrimSpace(token) == ""
+	return strings.TrimFunc(token, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.Is(unicode.Cf, r)
+	}) == ""
 }
 
 func parseDigits(s string) (int64, bool) {
@@ -274,22 +625,104 @@ func parseDigits(s string) (int64, bool) {
 	return val, err == nil
 }
 
+// isGroupDigits reports whether a digit token can be a group of a larger number,
+// a leading zero as in "0912" means it is a code, not an amount
+func isGroupDigits(s string) bool {
+	return s == "0" || !strings.HasPrefix(s, "0")
+}
+
+// parseGroup parses a cardinal number word or a digit group
+func parseGroup(s string) (int64, bool) {
+	if val, ok := persianNumberMap[s]; ok {
+		return val, true
+	}
+	if isGroupDigits(s) {
+		return parseDigits(s)
+	}
+	return 0, false
+}
+
+// parseOrdinalWithSuffix parses an ordinal made of a number word and a suffix, as in "پنجم", "سی‌ام", "صدمین",
+// "اولین" or "هزارم". "ام" only follows words that end in "ی", and "سه" only takes the irregular "سوم",
+// so words like "سیم" and "سهم" are not ordinals.
 func parseOrdinalWithSuffix(word string) (int64, bool) {
 	for _, suffix := range ordinalSuffixes {
-		if strings.HasSuffix(word, suffix) {
-			base := strings.TrimSuffix(word, suffix)
+		base, found := strings.CutSuffix(word, suffix)
+		if !found || base == "" {
+			continue
+		}
 
+		switch suffix {
+		case "ین":
+			if val, exists := ordinalNumberMap[base]; exists {
+				return val, true
+			}
+		case "ام", "امین":
+			if !strings.HasSuffix(base, "ی") {
+				continue
+			}
 			if val, exists := persianNumberMap[base]; exists {
 				return val, true
 			}
-			if val, exists := ordinalNumberMap[base]; exists {
+		default:
+			if strings.HasSuffix(base, "ی") || base == "سه" {
+				continue
+			}
+			if val, exists := persianNumberMap[base]; exists {
 				return val, true
 			}
+			if exponent, exists := scaleNumberMap[base]; exists {
+				return pow1000(exponent)
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseOrdinalScale parses an ordinal scale word such as "هزارم" or "میلیونمین" and returns its power of 1000
+func parseOrdinalScale(word string) (int, bool) {
+	for _, suffix := range []string{"مین", "م"} {
+		if base, found := strings.CutSuffix(word, suffix); found {
+			if exponent, exists := scaleNumberMap[base]; exists {
+				return exponent, true
+			}
 		}
 	}
 	return 0, false
 }
 
+// ordinalSuffixAt reports whether an ordinal suffix written as a separate token follows tokens[pos],
+// either after a half space as in "سی‌ام" or glued to digits as in "۲۰ام" and "۳مین", and returns its index
+func ordinalSuffixAt(tokens []Token, pos int) (int, bool) {
+	next := pos + 1
+	if next < len(tokens) && strings.Trim(tokens[next].Value, halfSpace) == "" {
+		next++
+	}
+	if next >= len(tokens) || tokens[next].StartIndex != tokens[next-1].EndIndex+1 {
+		return 0, false
+	}
+
+	switch tokens[next].Value {
+	case "ام", "امین":
+		return next, true
+	case "م", "مین":
+		return next, next == pos+1 && isNumeric(tokens[pos].Value)
+	}
+	return 0, false
+}
+
+// isOrdinalWord reports whether word is an ordinal number word such as "سوم" or "پنجمین"
+func isOrdinalWord(word string) bool {
+	if _, cardinal := persianNumberMap[word]; cardinal {
+		return false
+	}
+	if _, ok := ordinalNumberMap[word]; ok {
+		return true
+	}
+	_, ok := parseOrdinalWithSuffix(word)
+	return ok
+}
+
 func parseNextNumber(word string) (int64, bool) {
 	// Direct number word
 	if val, exists := persianNumberMap[word]; exists {
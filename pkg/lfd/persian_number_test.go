@@ -2,8 +2,13 @@ package lfd
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
+	"unicode/utf8"
+
+	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/numword"
 )
 
 func TestConvertWordsToIntFa(t *testing.T) {
@@ -351,9 +356,683 @@ func TestConvertWordsToIntFa(t *testing.T) {
 			expected: "شماره 12 3",
 			numbers:  []DetectedNumber{{Number: 12, StartIndex: 6, EndIndex: 7}, {Number: 3, StartIndex: 11, EndIndex: 12}},
 		},
+
+		// Scales above thousand
+		{
+			name:     "two_million_five_hundred_thousand",
+			input:    "دو میلیون و پانصد هزار",
+			expected: "2500000",
+			numbers:  []DetectedNumber{{Number: 2500000, StartIndex: 0, EndIndex: 21}},
+		},
+		{
+			name:     "nested_thousand_group",
+			input:    "سیصد و بیست هزار و چهل",
+			expected: "320040",
+			numbers:  []DetectedNumber{{Number: 320040, StartIndex: 0, EndIndex: 21}},
+		},
+		{
+			name:     "billion_without_conjunction",
+			input:    "قیمت سه میلیارد دویست میلیون تومان",
+			expected: "قیمت 3200000000 تومان",
+			numbers:  []DetectedNumber{{Number: 3200000000, StartIndex: 5, EndIndex: 27}},
+		},
+		{
+			name:     "thousand_billion",
+			input:    "هزار میلیارد",
+			expected: "1000000000000",
+			numbers:  []DetectedNumber{{Number: 1000000000000, StartIndex: 0, EndIndex: 11}},
+		},
+		{
+			name:     "trillion",
+			input:    "پنج تریلیون",
+			expected: "5000000000000000000",
+			numbers:  []DetectedNumber{{Number: 5000000000000000000, StartIndex: 0, EndIndex: 10}},
+		},
+		{
+			name:     "repeated_scale_starts_a_new_number",
+			input:    "یک میلیون و دو میلیون",
+			expected: "1000000 و 2000000",
+			numbers:  []DetectedNumber{{Number: 1000000, StartIndex: 0, EndIndex: 8}, {Number: 2000000, StartIndex: 12, EndIndex: 20}},
+		},
+		{
+			name:     "repeated_scale_without_conjunction",
+			input:    "دو هزار سه هزار",
+			expected: "2000 3000",
+			numbers:  []DetectedNumber{{Number: 2000, StartIndex: 0, EndIndex: 6}, {Number: 3000, StartIndex: 8, EndIndex: 14}},
+		},
+		{
+			name:     "glued_million_conjunction",
+			input:    "یک میلیونو دویست",
+			expected: "1000200",
+			numbers:  []DetectedNumber{{Number: 1000200, StartIndex: 0, EndIndex: 15}},
+		},
+		{
+			name:     "int64_overflow",
+			input:    "ده تریلیون تومان",
+			expected: "ده تریلیون تومان",
+			numbers:  []DetectedNumber{},
+		},
+		{
+			name:     "scale_overflow",
+			input:    "دو تریلیارد",
+			expected: "دو تریلیارد",
+			numbers:  []DetectedNumber{},
+		},
+		{
+			name:     "no_join_across_punctuation",
+			input:    "بیست، هزار",
+			expected: "20، 1000",
+			numbers:  []DetectedNumber{{Number: 20, StartIndex: 0, EndIndex: 3}, {Number: 1000, StartIndex: 6, EndIndex: 9}},
+		},
+
+		// Digits mixed with number words
+		{
+			name:     "digit_million",
+			input:    "۲ میلیون",
+			expected: "2000000",
+			numbers:  []DetectedNumber{{Number: 2000000, StartIndex: 0, EndIndex: 7}},
+		},
+		{
+			name:     "digit_thousand_and_digits",
+			input:    "3 هزار و 500",
+			expected: "3500",
+			numbers:  []DetectedNumber{{Number: 3500, StartIndex: 0, EndIndex: 11}},
+		},
+		{
+			name:     "digit_thousand_toman",
+			input:    "کرایه ۱۲ هزار تومن شد",
+			expected: "کرایه 12000 تومن شد",
+			numbers:  []DetectedNumber{{Number: 12000, StartIndex: 6, EndIndex: 12}},
+		},
+		{
+			name:     "digit_groups_between_scales",
+			input:    "2 میلیون 500 هزار",
+			expected: "2500000",
+			numbers:  []DetectedNumber{{Number: 2500000, StartIndex: 0, EndIndex: 16}},
+		},
+		{
+			name:     "digits_after_word_scale",
+			input:    "بیست و پنج هزار 12",
+			expected: "25000 12",
+			numbers:  []DetectedNumber{{Number: 25000, StartIndex: 0, EndIndex: 14}, {Number: 12, StartIndex: 16, EndIndex: 17}},
+		},
+		{
+			name:     "digit_group_after_word_scale",
+			input:    "دو میلیون 500 هزار",
+			expected: "2000000 500000",
+			numbers:  []DetectedNumber{{Number: 2000000, StartIndex: 0, EndIndex: 8}, {Number: 500000, StartIndex: 10, EndIndex: 17}},
+		},
+		{
+			name:     "digits_glued_to_scale",
+			input:    "۵هزار",
+			expected: "5000",
+			numbers:  []DetectedNumber{{Number: 5000, StartIndex: 0, EndIndex: 4}},
+		},
+		{
+			name:     "word_thousand_and_digits",
+			input:    "دو هزار و 300",
+			expected: "2300",
+			numbers:  []DetectedNumber{{Number: 2300, StartIndex: 0, EndIndex: 12}},
+		},
+		{
+			name:     "digits_with_conjunction_only",
+			input:    "3 و 4",
+			expected: "3 و 4",
+			numbers:  []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 0}, {Number: 4, StartIndex: 4, EndIndex: 4}},
+		},
+		{
+			name:     "leading_zero_is_not_a_group",
+			input:    "0912 هزار",
+			expected: "912 1000",
+			numbers:  []DetectedNumber{{Number: 912, StartIndex: 0, EndIndex: 3}, {Number: 1000, StartIndex: 5, EndIndex: 8}},
+		},
+	}
+
+	detector := &PersianNumberDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := detector.DetectNumbers(tt.input)
+			if !reflect.DeepEqual(results, tt.numbers) {
+				t.Errorf("input: %v, detected numbers: %v, want: %v", tt.input, results, tt.numbers)
+			}
+		})
+	}
+}
+
+func TestDetectNumbersRoundTrip(t *testing.T) {
+	numbers := []int{
+		1, 9, 15, 99, 100, 110, 999, 1000, 1235, 12356, 123567, 1_000_000, 1_001_000, 2_500_000,
+		320_040, 1_000_000_000, 7_000_000_019, 1_234_567_890_123, math.MaxInt64,
 	}
 
 	detector := &PersianNumberDetector{}
+	for _, number := range numbers {
+		words := internal.IntegerToPersian(number)
+		want := []DetectedNumber{{Number: int64(number), StartIndex: 0, EndIndex: utf8.RuneCountInString(words) - 1}}
+		if got := detector.DetectNumbers(words); !reflect.DeepEqual(got, want) {
+			t.Errorf("input: %v, detected numbers: %v, want: %v", words, got, want)
+		}
+	}
+}
+
+func TestDetectSpelledNumbersRoundTrip(t *testing.T) {
+	detector := &PersianNumberDetector{Fractions: true, Describe: true}
+	check := func(words string, want Rational, ordinal bool) {
+		t.Helper()
+		results := detector.DetectNumbers(words)
+		if len(results) != 1 {
+			t.Fatalf("input: %v, detected numbers: %v, want one number", words, results)
+		}
+		if got := results[0]; got.Value() != want || got.Ordinal != ordinal || got.Text != words {
+			t.Errorf("input: %v, detected number: %+v, want value %v", words, got, want)
+		}
+	}
+
+	for _, n := range []int64{1, 2, 3, 21, 30, 33, 100, 123, 1000, 1001, 2001, 120_000, 1_000_000, 7_000_019} {
+		check(numword.Ordinal(n), Rational{Numerator: n, Denominator: 1}, true)
+		check(numword.PrenominalOrdinal(n), Rational{Numerator: n, Denominator: 1}, true)
+	}
+	for _, d := range []struct {
+		input string
+		want  Rational
+	}{
+		{"2.5", Rational{Numerator: 5, Denominator: 2}},
+		{"3.25", Rational{Numerator: 13, Denominator: 4}},
+		{"0.0005", Rational{Numerator: 1, Denominator: 2000}},
+		{"12.125", Rational{Numerator: 97, Denominator: 8}},
+	} {
+		words, err := numword.Decimal(d.input)
+		if err != nil {
+			t.Fatalf("Decimal(%v) error = %v", d.input, err)
+		}
+		check(words, d.want, false)
+	}
+	for _, f := range []Rational{{1, 4}, {2, 3}, {7, 4}, {7, 30}, {1, 2}, {1, 1000}} {
+		words, err := numword.Fraction(f.Numerator, f.Denominator)
+		if err != nil {
+			t.Fatalf("Fraction(%v) error = %v", f, err)
+		}
+		check(words, f, false)
+	}
+}
+
+func TestDetectFractions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		numbers []DetectedNumber
+		values  []float64
+	}{
+		{
+			name:    "two_and_a_half",
+			input:   "دو و نیم",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 7, Fraction: Rational{Numerator: 5, Denominator: 2}}},
+			values:  []float64{2.5},
+		},
+		{
+			name:    "decimal_point_word",
+			input:   "سه ممیز پنج",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 10, Fraction: Rational{Numerator: 7, Denominator: 2}}},
+			values:  []float64{3.5},
+		},
+		{
+			name:    "decimal_point_compound_word",
+			input:   "دو ممیز بیست و پنج",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 17, Fraction: Rational{Numerator: 9, Denominator: 4}}},
+			values:  []float64{2.25},
+		},
+		{
+			name:    "one_quarter",
+			input:   "یک چهارم کیک",
+			numbers: []DetectedNumber{{Number: 0, StartIndex: 0, EndIndex: 7, Fraction: Rational{Numerator: 1, Denominator: 4}}},
+			values:  []float64{0.25},
+		},
+		{
+			name:    "two_thirds",
+			input:   "دو سوم",
+			numbers: []DetectedNumber{{Number: 0, StartIndex: 0, EndIndex: 5, Fraction: Rational{Numerator: 2, Denominator: 3}}},
+			values:  []float64{2.0 / 3},
+		},
+		{
+			name:    "mixed_fraction",
+			input:   "یک و سه چهارم",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 12, Fraction: Rational{Numerator: 7, Denominator: 4}}},
+			values:  []float64{1.75},
+		},
+		{
+			name:    "persian_decimal_separator",
+			input:   "۲٫۵ کیلو",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 2, Fraction: Rational{Numerator: 5, Denominator: 2}}},
+			values:  []float64{2.5},
+		},
+		{
+			name:    "half_hour",
+			input:   "نیم ساعت",
+			numbers: []DetectedNumber{{Number: 0, StartIndex: 0, EndIndex: 2, Fraction: Rational{Numerator: 1, Denominator: 2}}},
+			values:  []float64{0.5},
+		},
+		{
+			name:    "quarter_hour",
+			input:   "یک ربع",
+			numbers: []DetectedNumber{{Number: 0, StartIndex: 0, EndIndex: 5, Fraction: Rational{Numerator: 1, Denominator: 4}}},
+			values:  []float64{0.25},
+		},
+		{
+			name:    "half_million",
+			input:   "نیم میلیون",
+			numbers: []DetectedNumber{{Number: 500000, StartIndex: 0, EndIndex: 9}},
+			values:  []float64{500000},
+		},
+		{
+			name:    "decimal_million",
+			input:   "2.5 میلیون",
+			numbers: []DetectedNumber{{Number: 2500000, StartIndex: 0, EndIndex: 9}},
+			values:  []float64{2500000},
+		},
+		{
+			name:    "million_and_a_half",
+			input:   "دو میلیون و نیم",
+			numbers: []DetectedNumber{{Number: 2500000, StartIndex: 0, EndIndex: 14}},
+			values:  []float64{2500000},
+		},
+		{
+			name:    "decimal_int64_overflow",
+			input:   "۱۲۳۴۵۶۷۸۹۰۱۲۳۴۵۶۷۸۹۰٫۵ متر",
+			numbers: []DetectedNumber{},
+			values:  []float64{},
+		},
+		{
+			name:    "ordinal_is_not_a_denominator_when_larger",
+			input:   "سه دوم",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 1}, {Number: 2, StartIndex: 3, EndIndex: 5, Ordinal: true}},
+			values:  []float64{3, 2},
+		},
+	}
+
+	detector := &PersianNumberDetector{Fractions: true, Describe: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := withoutKind(t, tt.input, detector.DetectNumbers(tt.input))
+			if !reflect.DeepEqual(results, tt.numbers) {
+				t.Fatalf("input: %v, detected numbers: %v, want: %v", tt.input, results, tt.numbers)
+			}
+			for i, result := range results {
+				if got := result.Float64(); math.Abs(got-tt.values[i]) > 1e-9 {
+					t.Errorf("Float64() = %v, want %v", got, tt.values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDetectNumbersWithoutFractions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		numbers []DetectedNumber
+	}{
+		{
+			name:    "half_is_not_a_number",
+			input:   "نیم ساعت",
+			numbers: []DetectedNumber{},
+		},
+		{
+			name:    "quarter_is_not_a_number",
+			input:   "یک ربع",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 1}},
+		},
+		{
+			name:    "two_and_a_half_is_two",
+			input:   "دو و نیم",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 1}},
+		},
+		{
+			name:    "one_quarter_is_a_cardinal_and_an_ordinal",
+			input:   "یک چهارم",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 1}, {Number: 4, StartIndex: 3, EndIndex: 7}},
+		},
+		{
+			name:    "decimal_point_word",
+			input:   "سه ممیز پنج",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 1}, {Number: 5, StartIndex: 8, EndIndex: 10}},
+		},
+		{
+			name:    "decimal_separator_splits_digits",
+			input:   "۲٫۵ کیلو",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 0}, {Number: 5, StartIndex: 2, EndIndex: 2}},
+		},
+		{
+			name:    "million_and_a_half",
+			input:   "دو میلیون و نیم",
+			numbers: []DetectedNumber{{Number: 2000000, StartIndex: 0, EndIndex: 8}},
+		},
+	}
+
+	detector := &PersianNumberDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := detector.DetectNumbers(tt.input)
+			if !reflect.DeepEqual(results, tt.numbers) {
+				t.Errorf("input: %v, detected numbers: %v, want: %v", tt.input, results, tt.numbers)
+			}
+		})
+	}
+}
+
+func TestDetectColloquialNumbers(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		colloquial []DetectedNumber
+		formal     []DetectedNumber
+	}{
+		{
+			name:       "informal_one",
+			input:      "یه ماشین",
+			colloquial: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "counter_suffix",
+			input:      "دوتا بلیت",
+			colloquial: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "counter_after_half_space",
+			input:      "سه‌تا",
+			colloquial: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 1}},
+		},
+		{
+			name:       "hundred_counter",
+			input:      "صدتا",
+			colloquial: []DetectedNumber{{Number: 100, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "informal_counter",
+			input:      "هفتا",
+			colloquial: []DetectedNumber{{Number: 7, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "eighteen",
+			input:      "هیژده سالمه",
+			colloquial: []DetectedNumber{{Number: 18, StartIndex: 0, EndIndex: 4}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "compound",
+			input:      "بیست و شیش",
+			colloquial: []DetectedNumber{{Number: 26, StartIndex: 0, EndIndex: 9}},
+			formal:     []DetectedNumber{{Number: 20, StartIndex: 0, EndIndex: 3}},
+		},
+		{
+			name:       "seven_and_eight",
+			input:      "هف هش",
+			colloquial: []DetectedNumber{{Number: 7, StartIndex: 0, EndIndex: 1}, {Number: 8, StartIndex: 3, EndIndex: 4}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "toman",
+			input:      "پونصدتومن",
+			colloquial: []DetectedNumber{{Number: 500, StartIndex: 0, EndIndex: 4}},
+			formal:     []DetectedNumber{},
+		},
+		{
+			name:       "toman_with_scale",
+			input:      "بیست هزار تومن",
+			colloquial: []DetectedNumber{{Number: 20000, StartIndex: 0, EndIndex: 8}},
+			formal:     []DetectedNumber{{Number: 20000, StartIndex: 0, EndIndex: 8}},
+		},
+		{
+			name:       "digits_and_toman",
+			input:      "۵۰تومن",
+			colloquial: []DetectedNumber{{Number: 50, StartIndex: 0, EndIndex: 1}},
+			formal:     []DetectedNumber{{Number: 50, StartIndex: 0, EndIndex: 1}},
+		},
+		{
+			name:       "word_ending_with_suffix",
+			input:      "تاتا",
+			colloquial: []DetectedNumber{},
+			formal:     []DetectedNumber{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			colloquial := withoutKind(t, tt.input, (&PersianNumberDetector{Colloquial: true, Describe: true}).DetectNumbers(tt.input))
+			if !reflect.DeepEqual(colloquial, tt.colloquial) {
+				t.Errorf("colloquial: input: %v, detected numbers: %v, want: %v", tt.input, colloquial, tt.colloquial)
+			}
+			formal := withoutKind(t, tt.input, (&PersianNumberDetector{Describe: true}).DetectNumbers(tt.input))
+			if !reflect.DeepEqual(formal, tt.formal) {
+				t.Errorf("formal: input: %v, detected numbers: %v, want: %v", tt.input, formal, tt.formal)
+			}
+		})
+	}
+}
+
+func TestDetectOrdinals(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		numbers []DetectedNumber
+	}{
+		{
+			name:    "compound_ordinal",
+			input:   "بیست و یکم",
+			numbers: []DetectedNumber{{Number: 21, StartIndex: 0, EndIndex: 9, Ordinal: true}},
+		},
+		{
+			name:    "hundredth_with_suffix",
+			input:   "صدمین",
+			numbers: []DetectedNumber{{Number: 100, StartIndex: 0, EndIndex: 4, Ordinal: true}},
+		},
+		{
+			name:    "scale_compound_ordinal",
+			input:   "هزار و یکمین",
+			numbers: []DetectedNumber{{Number: 1001, StartIndex: 0, EndIndex: 11, Ordinal: true}},
+		},
+		{
+			name:    "first_with_suffix",
+			input:   "یکم",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 2, Ordinal: true}},
+		},
+		{
+			name:    "half_space_suffix",
+			input:   "سی‌ام",
+			numbers: []DetectedNumber{{Number: 30, StartIndex: 0, EndIndex: 4, Ordinal: true}},
+		},
+		{
+			name:    "glued_suffix",
+			input:   "سیامین",
+			numbers: []DetectedNumber{{Number: 30, StartIndex: 0, EndIndex: 5, Ordinal: true}},
+		},
+		{
+			name:    "digits_with_suffix",
+			input:   "طبقه ۳ام",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 5, EndIndex: 7, Ordinal: true}},
+		},
+		{
+			name:    "digits_with_short_suffix",
+			input:   "۲۰مین سالگرد",
+			numbers: []DetectedNumber{{Number: 20, StartIndex: 0, EndIndex: 4, Ordinal: true}},
+		},
+		{
+			name:    "ordinal_scale",
+			input:   "دو هزارمین",
+			numbers: []DetectedNumber{{Number: 2000, StartIndex: 0, EndIndex: 9, Ordinal: true}},
+		},
+		{
+			name:    "compound_ordinal_scale",
+			input:   "صد و بیست هزارم",
+			numbers: []DetectedNumber{{Number: 120000, StartIndex: 0, EndIndex: 14, Ordinal: true}},
+		},
+		{
+			name:    "bare_ordinal_scale",
+			input:   "میلیونمین",
+			numbers: []DetectedNumber{{Number: 1000000, StartIndex: 0, EndIndex: 8, Ordinal: true}},
+		},
+		{
+			name:    "irregular_first",
+			input:   "نخستین",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 0, EndIndex: 5, Ordinal: true}},
+		},
+		{
+			name:    "cardinal_before_noun",
+			input:   "سه طبقه",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 1}},
+		},
+		{
+			name:    "wire_is_not_thirtieth",
+			input:   "سیم کارت",
+			numbers: []DetectedNumber{},
+		},
+		{
+			name:    "share_is_not_third",
+			input:   "سهم من",
+			numbers: []DetectedNumber{},
+		},
+		{
+			name:    "ordinal_word",
+			input:   "سوم",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 0, EndIndex: 2, Ordinal: true}},
+		},
+		{
+			name:    "ordinals_with_suffix",
+			input:   "این اولین و دومین تست است",
+			numbers: []DetectedNumber{{Number: 1, StartIndex: 4, EndIndex: 8, Ordinal: true}, {Number: 2, StartIndex: 12, EndIndex: 16, Ordinal: true}},
+		},
+		{
+			name:    "teen_ordinal",
+			input:   "دوازدهم",
+			numbers: []DetectedNumber{{Number: 12, StartIndex: 0, EndIndex: 6, Ordinal: true}},
+		},
+		{
+			name:    "compound_ordinal_with_suffix",
+			input:   "بیست و پنجمین نمایشگاه آفرود",
+			numbers: []DetectedNumber{{Number: 25, StartIndex: 0, EndIndex: 12, Ordinal: true}},
+		},
+		{
+			name:    "ordinal_among_cardinals",
+			input:   "خیابان پنجم پلاک دوازده واحد هفت",
+			numbers: []DetectedNumber{{Number: 5, StartIndex: 7, EndIndex: 10, Ordinal: true}, {Number: 12, StartIndex: 17, EndIndex: 22}, {Number: 7, StartIndex: 29, EndIndex: 31}},
+		},
+	}
+
+	detector := &PersianNumberDetector{Describe: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := withoutKind(t, tt.input, detector.DetectNumbers(tt.input))
+			if !reflect.DeepEqual(results, tt.numbers) {
+				t.Errorf("input: %v, detected numbers: %v, want: %v", tt.input, results, tt.numbers)
+			}
+		})
+	}
+}
+
+func TestDetectNumberKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		numbers []DetectedNumber
+	}{
+		{
+			name:    "digits",
+			input:   "پلاک 25",
+			numbers: []DetectedNumber{{Number: 25, StartIndex: 5, EndIndex: 6, Kind: KindDigits, Text: "25"}},
+		},
+		{
+			name:    "words",
+			input:   "بیست و پنج نفر",
+			numbers: []DetectedNumber{{Number: 25, StartIndex: 0, EndIndex: 9, Kind: KindWords, Text: "بیست و پنج"}},
+		},
+		{
+			name:    "mixed",
+			input:   "۲ میلیون",
+			numbers: []DetectedNumber{{Number: 2000000, StartIndex: 0, EndIndex: 7, Kind: KindMixed, Text: "۲ میلیون"}},
+		},
+		{
+			name:    "ordinal",
+			input:   "طبقه سوم",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 5, EndIndex: 7, Ordinal: true, Kind: KindOrdinal, Text: "سوم"}},
+		},
+		{
+			name:    "fraction",
+			input:   "دو و نیم",
+			numbers: []DetectedNumber{{Number: 2, StartIndex: 0, EndIndex: 7, Fraction: Rational{Numerator: 5, Denominator: 2}, Kind: KindFraction, Text: "دو و نیم"}},
+		},
+		{
+			name:    "negative_word",
+			input:   "دما منفی پنج درجه",
+			numbers: []DetectedNumber{{Number: -5, StartIndex: 4, EndIndex: 11, Kind: KindNegative, Text: "منفی پنج"}},
+		},
+		{
+			name:    "negative_fraction",
+			input:   "منفی دو و نیم",
+			numbers: []DetectedNumber{{Number: -2, StartIndex: 0, EndIndex: 12, Fraction: Rational{Numerator: -5, Denominator: 2}, Kind: KindNegative, Text: "منفی دو و نیم"}},
+		},
+		{
+			name:    "minus_sign",
+			input:   "دما -۵ درجه",
+			numbers: []DetectedNumber{{Number: -5, StartIndex: 4, EndIndex: 5, Kind: KindNegative, Text: "-۵"}},
+		},
+		{
+			name:  "range_is_not_negative",
+			input: "10-20",
+			numbers: []DetectedNumber{
+				{Number: 10, StartIndex: 0, EndIndex: 1, Kind: KindDigits, Text: "10"},
+				{Number: 20, StartIndex: 3, EndIndex: 4, Kind: KindDigits, Text: "20"},
+			},
+		},
+		{
+			name:    "negative_ordinal_is_not_a_number",
+			input:   "منفی سوم",
+			numbers: []DetectedNumber{{Number: 3, StartIndex: 5, EndIndex: 7, Ordinal: true, Kind: KindOrdinal, Text: "سوم"}},
+		},
+		{
+			name:    "mobile_phone",
+			input:   "شماره ۰۹۱۲۱۲۳۴۵۶۷",
+			numbers: []DetectedNumber{{Number: 9121234567, StartIndex: 6, EndIndex: 16, Kind: KindPhone, Text: "۰۹۱۲۱۲۳۴۵۶۷"}},
+		},
+		{
+			name:    "international_phone",
+			input:   "989121234567",
+			numbers: []DetectedNumber{{Number: 989121234567, StartIndex: 0, EndIndex: 11, Kind: KindPhone, Text: "989121234567"}},
+		},
+		{
+			name:    "jalali_year",
+			input:   "سال ۱۴۰۲",
+			numbers: []DetectedNumber{{Number: 1402, StartIndex: 4, EndIndex: 7, Kind: KindYear, Text: "۱۴۰۲"}},
+		},
+		{
+			name:    "thousands_separators",
+			input:   "۲۵٬۰۰۰ تومان",
+			numbers: []DetectedNumber{{Number: 25000, StartIndex: 0, EndIndex: 5, Kind: KindDigits, Text: "۲۵٬۰۰۰"}},
+		},
+		{
+			name:    "thousands_separators_with_scale",
+			input:   "1,500 میلیون",
+			numbers: []DetectedNumber{{Number: 1500000000, StartIndex: 0, EndIndex: 11, Kind: KindMixed, Text: "1,500 میلیون"}},
+		},
+		{
+			name:  "list_is_not_grouped",
+			input: "۱، ۲",
+			numbers: []DetectedNumber{
+				{Number: 1, StartIndex: 0, EndIndex: 0, Kind: KindDigits, Text: "۱"},
+				{Number: 2, StartIndex: 3, EndIndex: 3, Kind: KindDigits, Text: "۲"},
+			},
+		},
+		{
+			name:    "amount_is_not_a_year",
+			input:   "5000",
+			numbers: []DetectedNumber{{Number: 5000, StartIndex: 0, EndIndex: 3, Kind: KindDigits, Text: "5000"}},
+		},
+	}
+
+	detector := &PersianNumberDetector{Fractions: true, Describe: true}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			results := detector.DetectNumbers(tt.input)
@@ -364,6 +1043,21 @@ func TestConvertWordsToIntFa(t *testing.T) {
 	}
 }
 
+// withoutKind checks that every number carries the text found at its position in input,
+// then clears the kind and the text so the tables above can compare values and positions only
+func withoutKind(t *testing.T, input string, numbers []DetectedNumber) []DetectedNumber {
+	t.Helper()
+	runes := []rune(input)
+	for i, number := range numbers {
+		if want := string(runes[number.StartIndex : number.EndIndex+1]); number.Text != want {
+			t.Errorf("input: %v, text of %v: %q, want: %q", input, number, number.Text, want)
+		}
+		numbers[i].Kind = ""
+		numbers[i].Text = ""
+	}
+	return numbers
+}
+
 // TestProcessTokensToNumbers tests the processTokensToNumbers function.
 // This test assumes that input characters are already normalized and
 // Persian digit characters are converted to their English equivalents.
@@ -0,0 +1,186 @@
+package lfd
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+const (
+	iranCountryCode = "98"
+	// nationalNumberLength is the length of a phone number without its country code or leading zero, as in "9123456789"
+	nationalNumberLength = 10
+	// maxPhoneDigits is the length of the longest way to write a phone number, as in "00989123456789"
+	maxPhoneDigits = 14
+	// maxPhoneGap is the longest run of separators between two parts of a phone number, as in "(021) 8877"
+	maxPhoneGap = 3
+	// phoneSeparators may separate the parts of a phone number once NormalizeCharacters has made dashes "_"
+	phoneSeparators = " _.()/‌"
+)
+
+var (
+	// mobilePrefixes are the first two digits of the national numbers of mobile operators
+	mobilePrefixes = []string{"90", "91", "92", "93", "94", "99"}
+
+	// landlineAreaCodes are the area codes of the provinces, without their leading zero
+	landlineAreaCodes = map[string]bool{
+		"11": true, "13": true, "17": true, "21": true, "23": true, "24": true, "25": true, "26": true,
+		"28": true, "31": true, "34": true, "35": true, "38": true, "41": true, "44": true, "45": true,
+		"51": true, "54": true, "56": true, "58": true, "61": true, "66": true, "71": true, "74": true,
+		"76": true, "77": true, "81": true, "83": true, "84": true, "86": true, "87": true,
+	}
+)
+
+// DetectPhoneNumbers finds the phone numbers for the phone step, as options.NormalizerOptions.PhoneDetector
+func DetectPhoneNumbers(input string) []options.PhoneNumber {
+	phones := (&PersianPhoneDetector{}).DetectPhones(input)
+	numbers := make([]options.PhoneNumber, 0, len(phones))
+	for _, phone := range phones {
+		numbers = append(numbers, options.PhoneNumber{
+			Start: phone.StartIndex, End: phone.EndIndex, E164: phone.Number, National: phone.National(),
+		})
+	}
+	return numbers
+}
+
+// National returns the phone number as it is dialed inside Iran, as in "09123456789"
+func (d DetectedPhone) National() string {
+	return "0" + strings.TrimPrefix(d.Number, "+"+iranCountryCode)
+}
+
+type PersianPhoneDetector struct{}
+
+// DetectPhones finds Iranian mobile and landline numbers written with digits of any script or with words,
+// in parts or with a country code, as in "۰۹۱۲ ۳۴۵ ۶۷۸۹", "+98 912-345-6789", "00989123456789" or
+// "۰۹۱۲ سیصد و چهل و پنج ۶۷ ۸۹"
+func (f *PersianPhoneDetector) DetectPhones(text string) []DetectedPhone {
+	phones := make([]DetectedPhone, 0)
+	if text == "" {
+		return phones
+	}
+
+	input := []rune(text)
+	normalized := internal.NewNormalizer(options.DefaultOptions).NormalizeCharacters(text)
+	parts := phoneParts(normalized, (&PersianNumberDetector{Describe: true}).DetectNumbers(text))
+
+	for i := 0; i < len(parts); {
+		phone, last, ok := parsePhone(normalized, parts, i)
+		if !ok {
+			i++
+			continue
+		}
+		phone.Text = string(input[phone.StartIndex : phone.EndIndex+1])
+		phones = append(phones, phone)
+		i = last + 1
+	}
+	return phones
+}
+
+// phonePart is a number that may be a part of a phone number, with its digits as they are written
+type phonePart struct {
+	start, end int
+	digits     string
+}
+
+// phoneParts keeps the whole numbers written only with digits or only with words, and spells out their digits.
+// Digits keep their leading zeros, as in "۰۹۱۲".
+func phoneParts(normalized []rune, numbers []DetectedNumber) []phonePart {
+	parts := make([]phonePart, 0, len(numbers))
+	for _, number := range numbers {
+		switch number.Kind {
+		case KindWords:
+			parts = append(parts, phonePart{number.StartIndex, number.EndIndex, strconv.FormatInt(number.Number, 10)})
+		case KindDigits, KindPhone, KindYear:
+			digits := strings.Map(func(r rune) rune {
+				if isDigitRune(r) {
+					return r
+				}
+				return -1
+			}, string(normalized[number.StartIndex:number.EndIndex+1]))
+			parts = append(parts, phonePart{number.StartIndex, number.EndIndex, digits})
+		}
+	}
+	return parts
+}
+
+// parsePhone finds the longest phone number made of parts[first:] and returns the index of its last part
+func parsePhone(normalized []rune, parts []phonePart, first int) (DetectedPhone, int, bool) {
+	start := parts[first].start
+	plus := start > 0 && normalized[start-1] == '+'
+	if plus {
+		start--
+	}
+
+	var (
+		found DetectedPhone
+		last  int
+		ok    bool
+		b     strings.Builder
+	)
+	for i := first; i < len(parts) && b.Len()+len(parts[i].digits) <= maxPhoneDigits; i++ {
+		if i > first && !isPhoneGap(normalized[parts[i-1].end+1:parts[i].start]) {
+			break
+		}
+		b.WriteString(parts[i].digits)
+		if national, phoneType, valid := canonicalPhone(b.String(), plus); valid {
+			found = DetectedPhone{Number: "+" + iranCountryCode + national, Type: phoneType, StartIndex: start, EndIndex: parts[i].end}
+			last, ok = i, true
+		}
+	}
+	// an area code in parentheses, as in "(021) 8877"
+	if ok && !plus && start > 0 && normalized[start-1] == '(' &&
+		strings.ContainsRune(string(normalized[start:found.EndIndex]), ')') {
+		found.StartIndex--
+	}
+	return found, last, ok
+}
+
+// isPhoneGap reports whether gap may separate two parts of a phone number
+func isPhoneGap(gap []rune) bool {
+	if len(gap) > maxPhoneGap {
+		return false
+	}
+	for _, r := range gap {
+		if !strings.ContainsRune(phoneSeparators, r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalPhone strips the country code or the leading zero of digits and validates what remains
+// as the national number of a mobile or a landline
+func canonicalPhone(digits string, plus bool) (string, PhoneType, bool) {
+	national, ok := "", false
+	switch {
+	case plus:
+		national, ok = strings.CutPrefix(digits, iranCountryCode)
+		// a zero after the country code is a common mistake, as in "+98 0912"
+		if len(national) == nationalNumberLength+1 {
+			national, ok = strings.CutPrefix(national, "0")
+		}
+	case strings.HasPrefix(digits, "00"+iranCountryCode):
+		national, ok = digits[len("00"+iranCountryCode):], true
+	case len(digits) == nationalNumberLength+len(iranCountryCode):
+		national, ok = strings.CutPrefix(digits, iranCountryCode)
+	case len(digits) == nationalNumberLength+1:
+		national, ok = strings.CutPrefix(digits, "0")
+	}
+	if !ok || len(national) != nationalNumberLength {
+		return "", "", false
+	}
+
+	for _, prefix := range mobilePrefixes {
+		if strings.HasPrefix(national, prefix) {
+			return national, PhoneTypeMobile, true
+		}
+	}
+	// a local landline number does not start with zero
+	if landlineAreaCodes[national[:2]] && national[2] != '0' {
+		return national, PhoneTypeLandline, true
+	}
+	return "", "", false
+}
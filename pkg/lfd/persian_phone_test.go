@@ -0,0 +1,79 @@
+package lfd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPersianPhoneDetector_DetectPhones(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		phones []DetectedPhone
+	}{
+		{
+			name:   "persian_digits_in_parts",
+			input:  "شماره ۰۹۱۲ ۳۴۵ ۶۷۸۹ است",
+			phones: []DetectedPhone{{Number: "+989123456789", Type: PhoneTypeMobile, StartIndex: 6, EndIndex: 18, Text: "۰۹۱۲ ۳۴۵ ۶۷۸۹"}},
+		},
+		{
+			name:   "country_code_and_dashes",
+			input:  "+98 912-345-6789",
+			phones: []DetectedPhone{{Number: "+989123456789", Type: PhoneTypeMobile, StartIndex: 0, EndIndex: 15, Text: "+98 912-345-6789"}},
+		},
+		{
+			name:   "international_prefix",
+			input:  "00989123456789",
+			phones: []DetectedPhone{{Number: "+989123456789", Type: PhoneTypeMobile, StartIndex: 0, EndIndex: 13, Text: "00989123456789"}},
+		},
+		{
+			name:   "zero_after_country_code",
+			input:  "+98 0935 123 4567",
+			phones: []DetectedPhone{{Number: "+989351234567", Type: PhoneTypeMobile, StartIndex: 0, EndIndex: 16, Text: "+98 0935 123 4567"}},
+		},
+		{
+			name:   "partly_in_words",
+			input:  "۰۹۱۲ سیصد و چهل و پنج ۶۷ ۸۹",
+			phones: []DetectedPhone{{Number: "+989123456789", Type: PhoneTypeMobile, StartIndex: 0, EndIndex: 26, Text: "۰۹۱۲ سیصد و چهل و پنج ۶۷ ۸۹"}},
+		},
+		{
+			name:   "in_words",
+			input:  "صفر نهصد و دوازده سیصد و چهل و پنج شصت و هفت هشتاد و نه",
+			phones: []DetectedPhone{{Number: "+989123456789", Type: PhoneTypeMobile, StartIndex: 0, EndIndex: 54, Text: "صفر نهصد و دوازده سیصد و چهل و پنج شصت و هفت هشتاد و نه"}},
+		},
+		{
+			name:   "landline_with_area_code_in_parentheses",
+			input:  "تلفن (021) 8877-6655",
+			phones: []DetectedPhone{{Number: "+982188776655", Type: PhoneTypeLandline, StartIndex: 5, EndIndex: 19, Text: "(021) 8877-6655"}},
+		},
+		{
+			name:  "several_numbers",
+			input: "۳ نفر با ۰۹۱۲۱۲۳۴۵۶۷ و ۰۲۱۸۸۷۷۶۶۵۵",
+			phones: []DetectedPhone{
+				{Number: "+989121234567", Type: PhoneTypeMobile, StartIndex: 9, EndIndex: 19, Text: "۰۹۱۲۱۲۳۴۵۶۷"},
+				{Number: "+982188776655", Type: PhoneTypeLandline, StartIndex: 23, EndIndex: 33, Text: "۰۲۱۸۸۷۷۶۶۵۵"},
+			},
+		},
+		{name: "unknown_prefix", input: "09612345678", phones: []DetectedPhone{}},
+		{name: "unknown_area_code", input: "02988776655", phones: []DetectedPhone{}},
+		{name: "too_short", input: "0912 345 678", phones: []DetectedPhone{}},
+		{name: "other_country", input: "+1 212 555 0100", phones: []DetectedPhone{}},
+		{name: "empty", input: "", phones: []DetectedPhone{}},
+	}
+
+	detector := &PersianPhoneDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detector.DetectPhones(tt.input); !reflect.DeepEqual(got, tt.phones) {
+				t.Errorf("DetectPhones() = %+v, want %+v", got, tt.phones)
+			}
+		})
+	}
+}
+
+func TestDetectedPhone_National(t *testing.T) {
+	phone := DetectedPhone{Number: "+989123456789"}
+	if got := phone.National(); got != "09123456789" {
+		t.Errorf("National() = %v, want %v", got, "09123456789")
+	}
+}
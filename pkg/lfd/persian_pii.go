@@ -0,0 +1,141 @@
+package lfd
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+// The patterns run on the output of NormalizeCharacters, which has English digits and "_" for dashes
+var (
+	// shebaRegex matches "IR" and 24 digits, also in groups as in "IR06 2960 0000 0010 0324 2000 01"
+	shebaRegex = regexp.MustCompile(`(?i)\bir\d{2}(?:[ _]?\d){22}`)
+	// cardRegex matches 16 digits, also in groups of four as in "6037-9975-9189-1234"
+	cardRegex = regexp.MustCompile(`\d{4}(?:\d{12}|(?: \d{4}){3}|(?:_\d{4}){3})`)
+	// nationalIDRegex matches 10 digits, also as "049-937089-9"
+	nationalIDRegex = regexp.MustCompile(`\d{10}|\d{3}_\d{6}_\d`)
+)
+
+// DetectPII finds the personal data for the PII masker step, as options.NormalizerOptions.PIIDetector
+func DetectPII(input string) []options.PII {
+	found := (&PersianPIIDetector{}).DetectPII(input)
+	pii := make([]options.PII, 0, len(found))
+	for _, p := range found {
+		pii = append(pii, options.PII{Start: p.StartIndex, End: p.EndIndex, Kind: p.Kind})
+	}
+	return pii
+}
+
+type PersianPIIDetector struct{}
+
+// DetectPII finds Iranian mobile numbers, national IDs, bank card numbers and Sheba numbers,
+// written with digits of any script. National IDs, cards and Sheba numbers must pass their checksums.
+func (f *PersianPIIDetector) DetectPII(text string) []DetectedPII {
+	found := make([]DetectedPII, 0)
+	if text == "" {
+		return found
+	}
+
+	input := []rune(text)
+	normalized := string(internal.NewNormalizer(options.DefaultOptions).NormalizeCharacters(text))
+
+	// longer patterns first, so the digits of a Sheba number are not read as a card number
+	patterns := []struct {
+		kind  options.PIIKind
+		re    *regexp.Regexp
+		valid func(digits string) bool
+	}{
+		{options.PIISheba, shebaRegex, isValidSheba},
+		{options.PIICard, cardRegex, isValidCard},
+		{options.PIINationalID, nationalIDRegex, isValidNationalID},
+	}
+	for _, pattern := range patterns {
+		for _, loc := range pattern.re.FindAllStringIndex(normalized, -1) {
+			start, end := loc[0], loc[1]
+			if touchesDigits(normalized, start, end) || !pattern.valid(onlyDigits(normalized[start:end])) {
+				continue
+			}
+			startIndex := utf8.RuneCountInString(normalized[:start])
+			endIndex := startIndex + utf8.RuneCountInString(normalized[start:end]) - 1
+			if overlapsPII(found, startIndex, endIndex) {
+				continue
+			}
+			found = append(found, DetectedPII{Kind: pattern.kind, StartIndex: startIndex, EndIndex: endIndex})
+		}
+	}
+
+	for _, phone := range (&PersianPhoneDetector{}).DetectPhones(text) {
+		if phone.Type == PhoneTypeMobile && !overlapsPII(found, phone.StartIndex, phone.EndIndex) {
+			found = append(found, DetectedPII{Kind: options.PIIMobile, StartIndex: phone.StartIndex, EndIndex: phone.EndIndex})
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].StartIndex < found[j].StartIndex })
+	for i := range found {
+		found[i].Text = string(input[found[i].StartIndex : found[i].EndIndex+1])
+	}
+	return found
+}
+
+// isValidNationalID checks the last digit of a کد ملی against the weighted sum of the other nine
+func isValidNationalID(digits string) bool {
+	if len(digits) != 10 || strings.Count(digits, digits[:1]) == len(digits) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(digits[i]-'0') * (10 - i)
+	}
+	check, rest := int(digits[9]-'0'), sum%11
+	if rest < 2 {
+		return check == rest
+	}
+	return check == 11-rest
+}
+
+// isValidCard applies the Luhn check to a bank card number
+func isValidCard(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isValidSheba applies the ISO 13616 mod-97 check to the digits of an IR Sheba number:
+// the country code, written as "1827" for I and R, and the check digits move after the account number
+func isValidSheba(digits string) bool {
+	n, ok := new(big.Int).SetString(digits[2:]+"1827"+digits[:2], 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func onlyDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isDigitRune(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// overlapsPII reports whether the runes from start to end are part of personal data already found
+func overlapsPII(found []DetectedPII, start, end int) bool {
+	for _, p := range found {
+		if start <= p.EndIndex && p.StartIndex <= end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package lfd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+func TestPersianPIIDetector_DetectPII(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pii   []DetectedPII
+	}{
+		{
+			name:  "mobile",
+			input: "شماره ۰۹۱۲ ۳۴۵ ۶۷۸۹",
+			pii:   []DetectedPII{{Kind: options.PIIMobile, StartIndex: 6, EndIndex: 18, Text: "۰۹۱۲ ۳۴۵ ۶۷۸۹"}},
+		},
+		{
+			name:  "national_id",
+			input: "کد ملی ۰۴۹۹۳۷۰۸۹۹",
+			pii:   []DetectedPII{{Kind: options.PIINationalID, StartIndex: 7, EndIndex: 16, Text: "۰۴۹۹۳۷۰۸۹۹"}},
+		},
+		{
+			name:  "national_id_with_dashes",
+			input: "049-937089-9",
+			pii:   []DetectedPII{{Kind: options.PIINationalID, StartIndex: 0, EndIndex: 11, Text: "049-937089-9"}},
+		},
+		{
+			name:  "card_in_groups",
+			input: "کارت 6037-9975-1234-5670 بانک",
+			pii:   []DetectedPII{{Kind: options.PIICard, StartIndex: 5, EndIndex: 23, Text: "6037-9975-1234-5670"}},
+		},
+		{
+			name:  "card_in_persian_digits",
+			input: "۶۰۳۷۹۹۷۵۱۲۳۴۵۶۷۰",
+			pii:   []DetectedPII{{Kind: options.PIICard, StartIndex: 0, EndIndex: 15, Text: "۶۰۳۷۹۹۷۵۱۲۳۴۵۶۷۰"}},
+		},
+		{
+			name:  "sheba_in_groups",
+			input: "شبا IR27 0170 0000 0010 0324 2000 01 است",
+			pii:   []DetectedPII{{Kind: options.PIISheba, StartIndex: 4, EndIndex: 35, Text: "IR27 0170 0000 0010 0324 2000 01"}},
+		},
+		{
+			name:  "several_kinds",
+			input: "ir270170000000100324200001 و 09123456789",
+			pii: []DetectedPII{
+				{Kind: options.PIISheba, StartIndex: 0, EndIndex: 25, Text: "ir270170000000100324200001"},
+				{Kind: options.PIIMobile, StartIndex: 29, EndIndex: 39, Text: "09123456789"},
+			},
+		},
+		{name: "wrong_national_id_check_digit", input: "0499370898", pii: []DetectedPII{}},
+		{name: "repeated_national_id_digits", input: "1111111111", pii: []DetectedPII{}},
+		{name: "wrong_card_check_digit", input: "6037997512345671", pii: []DetectedPII{}},
+		{name: "wrong_sheba_check_digits", input: "IR280170000000100324200001", pii: []DetectedPII{}},
+		{name: "landline", input: "02188776655", pii: []DetectedPII{}},
+		{name: "longer_digit_run", input: "04993708991", pii: []DetectedPII{}},
+		{name: "empty", input: "", pii: []DetectedPII{}},
+	}
+
+	detector := &PersianPIIDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detector.DetectPII(tt.input); !reflect.DeepEqual(got, tt.pii) {
+				t.Errorf("DetectPII() = %+v, want %+v", got, tt.pii)
+			}
+		})
+	}
+}
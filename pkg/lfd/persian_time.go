@@ -0,0 +1,369 @@
+package lfd
+
+import (
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/internal"
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+const (
+	hourWord    = "ساعت"  // "ساعت پنج" is a time of day, "پنج ساعت" is a duration
+	minuteWord  = "دقیقه" // as in "ساعت پنج و بیست دقیقه"
+	quarterWord = "ربع"   // "یه ربع" is a quarter of an hour on its own
+	andWord     = "و"
+	lessWord    = "کم" // "ده و ربع کم" is a quarter to ten
+	toWord      = "به" // "یه ربع به ده" is a quarter to ten
+)
+
+var (
+	// timeUnits are the units of durations, from the longest
+	timeUnits = []struct {
+		word string
+		unit time.Duration
+	}{
+		{hourWord, time.Hour},
+		{minuteWord, time.Minute},
+		{"ثانیه", time.Second},
+	}
+
+	// fromNowWords follow durations counted from now, as in "ربع ساعت دیگه"
+	fromNowWords = []string{"دیگه", "دیگر"}
+
+	// dayPeriods are the words after a time of day that tell the part of the day, longest first.
+	// toHour moves an hour of the 12-hour clock to the 24-hour clock.
+	dayPeriods = []struct {
+		word   string
+		toHour func(hour int) int
+	}{
+		{"بعد از ظهر", afternoon},
+		{"بعدازظهر", afternoon},
+		{"بامداد", func(hour int) int { return hour % 12 }},
+		{"صبح", func(hour int) int { return hour }},
+		{"ظهر", func(hour int) int { return addHalfDayIf(hour, hour < 6) }},
+		{"عصر", afternoon},
+		{"شب", func(hour int) int { return addHalfDayIf(hour%12, hour >= 5 && hour < 12) }},
+	}
+)
+
+func afternoon(hour int) int {
+	return addHalfDayIf(hour, hour < 12)
+}
+
+func addHalfDayIf(hour int, ok bool) int {
+	if ok {
+		return hour + 12
+	}
+	return hour
+}
+
+type PersianTimeDetector struct{}
+
+// DetectTimes finds times of day, as in "ساعت پنج و نیم عصر" or "۱۷:۳۰",
+// and durations, as in "دو ساعت و بیست دقیقه", "نیم ساعت" or "یه ربع دیگه"
+func (f *PersianTimeDetector) DetectTimes(text string) []DetectedTime {
+	if text == "" {
+		return []DetectedTime{}
+	}
+
+	input := []rune(text)
+	parser := timeParser{
+		normalized: internal.NewNormalizer(options.DefaultOptions).NormalizeCharacters(text),
+		numbers:    make(map[int]DetectedNumber),
+	}
+	for _, number := range (&PersianNumberDetector{Colloquial: true, Fractions: true, Describe: true}).DetectNumbers(text) {
+		parser.numbers[number.StartIndex] = number
+	}
+
+	times := make([]DetectedTime, 0)
+	for pos := 0; pos < len(input); pos++ {
+		if pos > 0 && isWordRune(parser.normalized[pos-1]) {
+			continue
+		}
+		found, ok := parser.parseAt(pos)
+		if !ok {
+			continue
+		}
+		found.Text = string(input[found.StartIndex : found.EndIndex+1])
+		times = append(times, found)
+		pos = found.EndIndex
+	}
+	return times
+}
+
+// timeParser reads times from the text NormalizeCharacters made of the input and the numbers detected in it
+type timeParser struct {
+	normalized []rune
+	// numbers maps the start of every detected number to it
+	numbers map[int]DetectedNumber
+}
+
+// parseAt parses a time of day or a duration that starts at pos
+func (p timeParser) parseAt(pos int) (DetectedTime, bool) {
+	if hasWordAt(p.normalized, pos, hourWord) {
+		if found, ok := p.parseClock(pos, skipSpaces(p.normalized, pos+len([]rune(hourWord))), false); ok {
+			return found, true
+		}
+	}
+	if found, ok := p.parseClock(pos, pos, true); ok {
+		return found, true
+	}
+	return p.parseDuration(pos)
+}
+
+// parseClock parses a time of day at pos, written with digits as in "۱۷:۳۰", with the minutes to an hour
+// as in "ده دقیقه به پنج", or with an hour and its minutes as in "پنج و نیم" or "پنج و بیست دقیقه",
+// and the part of the day after it. Without "ساعت" before it, a spelled hour needs the part of the day,
+// as in "پنج عصر".
+func (p timeParser) parseClock(start, pos int, needsPeriod bool) (DetectedTime, bool) {
+	clock, end, ok := p.digitClockAt(pos)
+	if !ok {
+		clock, end, ok = p.clockToAt(pos)
+	}
+	if ok {
+		needsPeriod = false
+	} else {
+		clock, end, ok = p.spelledClockAt(pos)
+	}
+	if !ok {
+		return DetectedTime{}, false
+	}
+
+	hasPeriod := false
+	next := skipSpaces(p.normalized, end)
+	for _, period := range dayPeriods {
+		if hasWordAt(p.normalized, next, period.word) {
+			clock.Hour = period.toHour(clock.Hour)
+			end = next + len([]rune(period.word))
+			hasPeriod = true
+			break
+		}
+	}
+	if (needsPeriod && !hasPeriod) || clock.Hour > 23 {
+		return DetectedTime{}, false
+	}
+	return DetectedTime{Kind: TimeKindClock, Clock: clock, StartIndex: start, EndIndex: end - 1}, true
+}
+
+// digitClockAt parses "17:30" or "17:30:15" at pos, and returns the index right after it
+func (p timeParser) digitClockAt(pos int) (Clock, int, bool) {
+	var parts []int
+	end := pos
+	for {
+		value, next := digitsAt(p.normalized, end)
+		length := next - end
+		if (len(parts) == 0 && (length < 1 || length > 2)) || (len(parts) > 0 && length != 2) {
+			return Clock{}, 0, false
+		}
+		parts = append(parts, value)
+		end = next
+		if len(parts) == 3 || end+1 >= len(p.normalized) || p.normalized[end] != ':' || !isDigitRune(p.normalized[end+1]) {
+			break
+		}
+		end++
+	}
+	if len(parts) < 2 || isWordRune(runeAt(p.normalized, end)) || (runeAt(p.normalized, end) == ':' && isDigitRune(runeAt(p.normalized, end+1))) {
+		return Clock{}, 0, false
+	}
+	if parts[1] > 59 || (len(parts) == 3 && parts[2] > 59) {
+		return Clock{}, 0, false
+	}
+	clock := Clock{Hour: parts[0], Minute: parts[1]}
+	if len(parts) == 3 {
+		clock.Second = parts[2]
+	}
+	return clock, end, true
+}
+
+// spelledClockAt parses an hour at pos with the minutes after it, as in "پنج و نیم", "پنج و ربع" or "۵ و ۲۰ دقیقه"
+func (p timeParser) spelledClockAt(pos int) (Clock, int, bool) {
+	hour, ok := p.numbers[pos]
+	if !ok || hour.Ordinal || hour.Number < 0 || hour.Number > 24 {
+		return Clock{}, 0, false
+	}
+	minutes, ok := ratMinutes(new(big.Rat).Sub(hour.Value().Rat(), new(big.Rat).SetInt64(hour.Number)))
+	if !ok {
+		return Clock{}, 0, false
+	}
+	end := hour.EndIndex + 1
+
+	// the minutes of a whole hour follow "و", as in "پنج و بیست دقیقه"
+	if next := skipSpaces(p.normalized, end); !hour.IsFraction() && hasWordAt(p.normalized, next, andWord) {
+		if number, ok := p.numbers[skipSpaces(p.normalized, next+1)]; ok && !number.Ordinal {
+			value := number.Value().Rat()
+			if value.Cmp(big.NewRat(1, 1)) < 0 {
+				minutes, ok = ratMinutes(value)
+			} else {
+				minutes, ok = int(number.Number), !number.IsFraction() && number.Number < 60
+			}
+			if ok {
+				end = number.EndIndex + 1
+				if next := skipSpaces(p.normalized, end); hasWordAt(p.normalized, next, minuteWord) {
+					end = next + len([]rune(minuteWord))
+				}
+			}
+		}
+	}
+	// "کم" takes the minutes from the hour, as in "ده و ربع کم"
+	if next := skipSpaces(p.normalized, end); hasWordAt(p.normalized, next, lessWord) {
+		end = next + len([]rune(lessWord))
+		// "پنج ربع کم" is a quarter to five, not five quarters
+		if words := strings.Fields(hour.Text); len(words) == 2 && words[1] == quarterWord {
+			if quarters := new(big.Rat).Mul(hour.Value().Rat(), big.NewRat(4, 1)); quarters.IsInt() {
+				if n := quarters.Num().Int64(); n >= 2 && n <= 24 {
+					return clockBefore(int(n), 15), end, true
+				}
+			}
+		}
+		if minutes > 0 {
+			return clockBefore(int(hour.Number), minutes), end, true
+		}
+	}
+	return Clock{Hour: int(hour.Number), Minute: minutes}, end, true
+}
+
+// clockToAt parses the minutes to an hour at pos, as in "یه ربع به ده" or "بیست دقیقه به پنج"
+func (p timeParser) clockToAt(pos int) (Clock, int, bool) {
+	number, ok := p.numbers[pos]
+	if !ok || number.Ordinal || number.Value().Rat().Sign() <= 0 {
+		return Clock{}, 0, false
+	}
+	end := number.EndIndex + 1
+	next := skipSpaces(p.normalized, end)
+	var minutes int
+	if value := number.Value().Rat(); value.Cmp(big.NewRat(1, 1)) < 0 {
+		// a fraction of an hour, as in "یه ربع"
+		if minutes, ok = ratMinutes(value); !ok {
+			return Clock{}, 0, false
+		}
+	} else {
+		// whole minutes, as in "بیست دقیقه"
+		if number.IsFraction() || number.Number >= 60 || !hasWordAt(p.normalized, next, minuteWord) {
+			return Clock{}, 0, false
+		}
+		minutes = int(number.Number)
+	}
+	if hasWordAt(p.normalized, next, minuteWord) {
+		next = skipSpaces(p.normalized, next+len([]rune(minuteWord)))
+	}
+	if !hasWordAt(p.normalized, next, toWord) {
+		return Clock{}, 0, false
+	}
+	hour, ok := p.numbers[skipSpaces(p.normalized, next+len([]rune(toWord)))]
+	if !ok || hour.Ordinal || hour.IsFraction() || hour.Number < 0 || hour.Number > 24 {
+		return Clock{}, 0, false
+	}
+	return clockBefore(int(hour.Number), minutes), hour.EndIndex + 1, true
+}
+
+// clockBefore returns the time the given minutes before the hour
+func clockBefore(hour, minutes int) Clock {
+	hour--
+	if hour < 0 {
+		hour = 23
+	}
+	return Clock{Hour: hour, Minute: 60 - minutes}
+}
+
+// parseDuration parses a duration at pos, as in "دو ساعت و بیست دقیقه", "دو ساعت و نیم" or "یه ربع",
+// and the word after it that counts it from now
+func (p timeParser) parseDuration(pos int) (DetectedTime, bool) {
+	number, ok := p.numbers[pos]
+	if !ok || number.Ordinal || number.Value().Rat().Sign() <= 0 {
+		return DetectedTime{}, false
+	}
+	unit, end, ok := p.unitAt(skipSpaces(p.normalized, number.EndIndex+1))
+	if !ok {
+		if !strings.HasSuffix(number.Text, quarterWord) {
+			return DetectedTime{}, false
+		}
+		unit, end = time.Hour, number.EndIndex+1
+	}
+	total := new(big.Rat).Mul(number.Value().Rat(), new(big.Rat).SetInt64(int64(unit)))
+
+	// a shorter unit or a fraction of the last unit may follow "و"
+	for {
+		next := skipSpaces(p.normalized, end)
+		if !hasWordAt(p.normalized, next, andWord) {
+			break
+		}
+		part, ok := p.numbers[skipSpaces(p.normalized, next+1)]
+		if !ok || part.Ordinal || part.Value().Rat().Sign() <= 0 {
+			break
+		}
+		partUnit, partEnd, ok := p.unitAt(skipSpaces(p.normalized, part.EndIndex+1))
+		if ok && partUnit < unit {
+			total.Add(total, new(big.Rat).Mul(part.Value().Rat(), new(big.Rat).SetInt64(int64(partUnit))))
+			unit, end = partUnit, partEnd
+			continue
+		}
+		if !ok && part.Number == 0 {
+			// "دو ساعت و نیم"
+			total.Add(total, new(big.Rat).Mul(part.Value().Rat(), new(big.Rat).SetInt64(int64(unit))))
+			end = part.EndIndex + 1
+		}
+		break
+	}
+	if !total.IsInt() || !total.Num().IsInt64() {
+		return DetectedTime{}, false
+	}
+
+	found := DetectedTime{Kind: TimeKindDuration, Duration: time.Duration(total.Num().Int64()), StartIndex: pos}
+	next := skipSpaces(p.normalized, end)
+	for _, word := range fromNowWords {
+		if hasWordAt(p.normalized, next, word) {
+			found.FromNow = true
+			end = next + len([]rune(word))
+			break
+		}
+	}
+	found.EndIndex = end - 1
+	return found, true
+}
+
+// unitAt matches the word of a duration unit at pos, and returns the unit and the index right after it
+func (p timeParser) unitAt(pos int) (time.Duration, int, bool) {
+	for _, unit := range timeUnits {
+		if hasWordAt(p.normalized, pos, unit.word) {
+			return unit.unit, pos + len([]rune(unit.word)), true
+		}
+	}
+	return 0, 0, false
+}
+
+// ratMinutes converts a fraction of an hour to whole minutes
+func ratMinutes(hours *big.Rat) (int, bool) {
+	minutes := new(big.Rat).Mul(hours, big.NewRat(60, 1))
+	if !minutes.IsInt() {
+		return 0, false
+	}
+	return int(minutes.Num().Int64()), true
+}
+
+// digitsAt returns the value of the English digits at normalized[pos] and the index right after them
+func digitsAt(normalized []rune, pos int) (int, int) {
+	value := 0
+	for ; pos < len(normalized) && isDigitRune(normalized[pos]) && value < 1000; pos++ {
+		value = value*10 + int(normalized[pos]-'0')
+	}
+	return value, pos
+}
+
+func isDigitRune(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// runeAt returns normalized[pos], or zero past the end
+func runeAt(normalized []rune, pos int) rune {
+	if pos < len(normalized) {
+		return normalized[pos]
+	}
+	return 0
+}
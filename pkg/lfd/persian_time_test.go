@@ -0,0 +1,117 @@
+package lfd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPersianTimeDetector_DetectTimes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		times []DetectedTime
+	}{
+		{
+			name:  "spelled_clock_with_fraction_and_period",
+			input: "ساعت پنج و نیم عصر بیا",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 17, Minute: 30}, StartIndex: 0, EndIndex: 17, Text: "ساعت پنج و نیم عصر"}},
+		},
+		{
+			name:  "clock_with_quarter_less",
+			input: "ساعت ده و ربع کم",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 9, Minute: 45}, StartIndex: 0, EndIndex: 15, Text: "ساعت ده و ربع کم"}},
+		},
+		{
+			name:  "clock_with_minutes_less",
+			input: "ساعت پنج و ده دقیقه کم",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 4, Minute: 50}, StartIndex: 0, EndIndex: 21, Text: "ساعت پنج و ده دقیقه کم"}},
+		},
+		{
+			name:  "clock_with_quarters_less",
+			input: "ساعت پنج ربع کم",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 4, Minute: 45}, StartIndex: 0, EndIndex: 14, Text: "ساعت پنج ربع کم"}},
+		},
+		{
+			name:  "quarter_to_hour",
+			input: "یه ربع به ده",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 9, Minute: 45}, StartIndex: 0, EndIndex: 11, Text: "یه ربع به ده"}},
+		},
+		{
+			name:  "minutes_to_hour_with_period",
+			input: "بیست دقیقه به پنج عصر",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 16, Minute: 40}, StartIndex: 0, EndIndex: 20, Text: "بیست دقیقه به پنج عصر"}},
+		},
+		{
+			name:  "digit_clock",
+			input: "حرکت ۱۷:۳۰",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 17, Minute: 30}, StartIndex: 5, EndIndex: 9, Text: "۱۷:۳۰"}},
+		},
+		{
+			name:  "digit_clock_with_seconds_after_hour_word",
+			input: "ساعت 08:05:30",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 8, Minute: 5, Second: 30}, StartIndex: 0, EndIndex: 12, Text: "ساعت 08:05:30"}},
+		},
+		{
+			name:  "clock_with_minutes",
+			input: "ساعت ۹ و ۲۰ دقیقه شب",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 21, Minute: 20}, StartIndex: 0, EndIndex: 19, Text: "ساعت ۹ و ۲۰ دقیقه شب"}},
+		},
+		{
+			name:  "clock_with_quarter",
+			input: "ساعت دوازده و ربع ظهر",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 12, Minute: 15}, StartIndex: 0, EndIndex: 20, Text: "ساعت دوازده و ربع ظهر"}},
+		},
+		{
+			name:  "clock_with_period_only",
+			input: "فردا هشت صبح",
+			times: []DetectedTime{{Kind: TimeKindClock, Clock: Clock{Hour: 8}, StartIndex: 5, EndIndex: 11, Text: "هشت صبح"}},
+		},
+		{
+			name:  "quarter_hour_from_now",
+			input: "ربع ساعت دیگه میرسم",
+			times: []DetectedTime{{Kind: TimeKindDuration, Duration: 15 * time.Minute, FromNow: true, StartIndex: 0, EndIndex: 12, Text: "ربع ساعت دیگه"}},
+		},
+		{
+			name:  "hours_and_minutes",
+			input: "دو ساعت و بیست دقیقه",
+			times: []DetectedTime{{Kind: TimeKindDuration, Duration: 2*time.Hour + 20*time.Minute, StartIndex: 0, EndIndex: 19, Text: "دو ساعت و بیست دقیقه"}},
+		},
+		{
+			name:  "half_hour",
+			input: "نیم ساعت",
+			times: []DetectedTime{{Kind: TimeKindDuration, Duration: 30 * time.Minute, StartIndex: 0, EndIndex: 7, Text: "نیم ساعت"}},
+		},
+		{
+			name:  "hours_and_a_half",
+			input: "دو ساعت و نیم",
+			times: []DetectedTime{{Kind: TimeKindDuration, Duration: 150 * time.Minute, StartIndex: 0, EndIndex: 12, Text: "دو ساعت و نیم"}},
+		},
+		{
+			name:  "colloquial_quarter_without_unit",
+			input: "یه ربع دیگه",
+			times: []DetectedTime{{Kind: TimeKindDuration, Duration: 15 * time.Minute, FromNow: true, StartIndex: 0, EndIndex: 10, Text: "یه ربع دیگه"}},
+		},
+		{
+			name:  "clock_and_duration",
+			input: "ساعت ۸ حرکت و ۴۵ دقیقه در راه",
+			times: []DetectedTime{
+				{Kind: TimeKindClock, Clock: Clock{Hour: 8}, StartIndex: 0, EndIndex: 5, Text: "ساعت ۸"},
+				{Kind: TimeKindDuration, Duration: 45 * time.Minute, StartIndex: 14, EndIndex: 21, Text: "۴۵ دقیقه"},
+			},
+		},
+		{name: "number_without_unit", input: "پنج نفر", times: []DetectedTime{}},
+		{name: "hour_out_of_range", input: "۲۵:۱۰", times: []DetectedTime{}},
+		{name: "digits_in_a_longer_run", input: "12:30:45:10", times: []DetectedTime{}},
+		{name: "empty", input: "", times: []DetectedTime{}},
+	}
+
+	detector := &PersianTimeDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detector.DetectTimes(tt.input); !reflect.DeepEqual(got, tt.times) {
+				t.Errorf("DetectTimes() = %+v, want %+v", got, tt.times)
+			}
+		})
+	}
+}
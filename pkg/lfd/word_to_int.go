@@ -0,0 +1,64 @@
+package lfd
+
+import (
+	"strconv"
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/pkg/options"
+)
+
+// DetectNumberWords finds the numbers that have at least one word, for the word to int step
+// as options.NormalizerOptions.NumberWordDetector.
+// Numbers written only with digits are left alone, so codes such as "0912" keep their leading zero.
+func DetectNumberWords(input string) []options.NumberWord {
+	detector := &PersianNumberDetector{Fractions: true, Describe: true}
+	numbers := detector.DetectNumbers(input)
+
+	words := make([]options.NumberWord, 0, len(numbers))
+	for _, number := range numbers {
+		if !hasLetter(number.Text) {
+			continue
+		}
+		digits, ok := formatDigits(number)
+		if !ok {
+			continue
+		}
+		words = append(words, options.NumberWord{Start: number.StartIndex, End: number.EndIndex, Digits: digits})
+	}
+	return words
+}
+
+// formatDigits writes the value of a number with English digits, as in "25000", "-3" or "2.5".
+// Fractions without a finite decimal form such as "یک سوم" cannot be written exactly and are not formatted.
+func formatDigits(number DetectedNumber) (string, bool) {
+	if !number.IsFraction() {
+		return strconv.FormatInt(number.Number, 10), true
+	}
+
+	// a fraction in lowest terms has a finite decimal form when its denominator is 2^a * 5^b,
+	// and then it has max(a, b) decimal places
+	places := 0
+	for d, twos, fives := number.Fraction.Denominator, 0, 0; d > 1; {
+		switch {
+		case d%2 == 0:
+			d /= 2
+			twos++
+		case d%5 == 0:
+			d /= 5
+			fives++
+		default:
+			return "", false
+		}
+		places = max(twos, fives)
+	}
+	return number.Fraction.Rat().FloatString(places), true
+}
+
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package money
+
+import (
+	"unicode"
+
+	"github.com/snapp-incubator/seperno/pkg/lfd"
+)
+
+// currencyWords maps the words written after an amount to its currency
+var currencyWords = []struct {
+	word     string
+	currency Currency
+}{
+	{"ریال", Rial},
+	{"ريال", Rial}, // with Arabic yeh
+	{"تومان", Toman},
+	{"تومن", Toman},
+}
+
+// DetectedAmount is an amount found in a text with the rune positions of its first and last rune,
+// the currency word included
+type DetectedAmount struct {
+	Amount
+	StartIndex int
+	EndIndex   int
+	Text       string
+}
+
+type AmountDetector interface {
+	DetectAmounts(text string) []DetectedAmount
+}
+
+// PersianAmountDetector finds amounts written with digits or words and followed by their currency,
+// as in "۲۵ هزار تومن", "250000 ریال" or "دو و نیم میلیون تومان"
+type PersianAmountDetector struct{}
+
+// DetectAmounts returns the amounts of text in order
+func (d *PersianAmountDetector) DetectAmounts(text string) []DetectedAmount {
+	runes := []rune(text)
+	numbers := (&lfd.PersianNumberDetector{Colloquial: true, Fractions: true, Describe: true}).DetectNumbers(text)
+
+	amounts := make([]DetectedAmount, 0)
+	for _, number := range numbers {
+		if number.Ordinal || number.IsFraction() || number.Number < 0 {
+			continue
+		}
+		currency, end, ok := currencyAt(runes, number.EndIndex+1)
+		if !ok {
+			continue
+		}
+		amounts = append(amounts, DetectedAmount{
+			Amount:     Amount{Value: number.Number, Currency: currency},
+			StartIndex: number.StartIndex,
+			EndIndex:   end,
+			Text:       string(runes[number.StartIndex : end+1]),
+		})
+	}
+	return amounts
+}
+
+// currencyAt finds a currency word at runes[pos], after optional spaces and half spaces,
+// and returns its currency and the index of its last rune
+func currencyAt(runes []rune, pos int) (Currency, int, bool) {
+	for pos < len(runes) && (unicode.IsSpace(runes[pos]) || unicode.Is(unicode.Cf, runes[pos])) {
+		pos++
+	}
+	for _, candidate := range currencyWords {
+		word := []rune(candidate.word)
+		end := pos + len(word)
+		if end > len(runes) || string(runes[pos:end]) != candidate.word {
+			continue
+		}
+		if end < len(runes) && unicode.IsLetter(runes[end]) {
+			continue // a longer word such as "ریالی"
+		}
+		return candidate.currency, end - 1, true
+	}
+	return "", 0, false
+}
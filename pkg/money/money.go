@@ -0,0 +1,100 @@
+// Package money detects, converts and formats amounts of Iranian rial and toman.
+package money
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/snapp-incubator/seperno/pkg/numword"
+)
+
+type Currency string
+
+const (
+	Rial  Currency = "rial"
+	Toman Currency = "toman"
+)
+
+// rialsPerToman is the number of rials in one toman
+const rialsPerToman = 10
+
+// thousandsSeparator is the Arabic thousands separator used between digit groups, as in "۲۵٬۰۰۰"
+const thousandsSeparator = '٬'
+
+// unitWords maps each currency to the word written after its amounts
+var unitWords = map[Currency]string{
+	Rial:  "ریال",
+	Toman: "تومان",
+}
+
+// Amount is a whole amount of money in a currency
+type Amount struct {
+	Value    int64
+	Currency Currency
+}
+
+// Rials returns the amount in rials.
+// It reports false when the amount in rials does not fit in an int64.
+func (a Amount) Rials() (int64, bool) {
+	if a.Currency != Toman {
+		return a.Value, true
+	}
+	if a.Value > math.MaxInt64/rialsPerToman || a.Value < math.MinInt64/rialsPerToman {
+		return 0, false
+	}
+	return a.Value * rialsPerToman, true
+}
+
+// Convert returns the amount in currency to.
+// It reports false when the amount has no whole value in to, as for 25 rials in toman,
+// or when it does not fit in an int64 in rials.
+func (a Amount) Convert(to Currency) (Amount, bool) {
+	if a.Currency == to {
+		return a, true
+	}
+	rials, ok := a.Rials()
+	if !ok {
+		return Amount{}, false
+	}
+	if to == Toman {
+		if rials%rialsPerToman != 0 {
+			return Amount{}, false
+		}
+		return Amount{Value: rials / rialsPerToman, Currency: Toman}, true
+	}
+	return Amount{Value: rials, Currency: Rial}, true
+}
+
+// Words spells the amount with its unit, as in "بیست و پنج هزار تومان"
+func (a Amount) Words(opts ...numword.Option) string {
+	return numword.Cardinal(a.Value, opts...) + " " + unitWords[a.Currency]
+}
+
+// Digits writes the amount with Persian digits grouped by "٬" and its unit, as in "۲۵٬۰۰۰ تومان"
+func (a Amount) Digits() string {
+	return GroupDigits(a.Value) + " " + unitWords[a.Currency]
+}
+
+func (a Amount) String() string {
+	return a.Digits()
+}
+
+// GroupDigits writes n with Persian digits and "٬" between groups of three digits, as in "۲٬۵۰۰٬۰۰۰"
+func GroupDigits(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteRune(thousandsSeparator)
+		}
+		b.WriteRune('۰' + d - '0')
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package money
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/snapp-incubator/seperno/pkg/numword"
+)
+
+func TestPersianAmountDetector_DetectAmounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		amounts []DetectedAmount
+	}{
+		{
+			name:    "digits_and_scale_in_colloquial_toman",
+			input:   "۲۵ هزار تومن",
+			amounts: []DetectedAmount{{Amount: Amount{Value: 25000, Currency: Toman}, StartIndex: 0, EndIndex: 11, Text: "۲۵ هزار تومن"}},
+		},
+		{
+			name:    "digits_in_rial",
+			input:   "مبلغ 250000 ریال",
+			amounts: []DetectedAmount{{Amount: Amount{Value: 250000, Currency: Rial}, StartIndex: 5, EndIndex: 15, Text: "250000 ریال"}},
+		},
+		{
+			name:    "words",
+			input:   "دو و نیم میلیون تومان کرایه",
+			amounts: []DetectedAmount{{Amount: Amount{Value: 2500000, Currency: Toman}, StartIndex: 0, EndIndex: 20, Text: "دو و نیم میلیون تومان"}},
+		},
+		{
+			name:    "grouped_digits",
+			input:   "۱۲٬۵۰۰ تومان",
+			amounts: []DetectedAmount{{Amount: Amount{Value: 12500, Currency: Toman}, StartIndex: 0, EndIndex: 11, Text: "۱۲٬۵۰۰ تومان"}},
+		},
+		{
+			name:    "glued_unit",
+			input:   "پونصدتومن",
+			amounts: []DetectedAmount{{Amount: Amount{Value: 500, Currency: Toman}, StartIndex: 0, EndIndex: 8, Text: "پونصدتومن"}},
+		},
+		{
+			name:  "several_amounts",
+			input: "۵۰ تومان یا ۵۰۰ ریال",
+			amounts: []DetectedAmount{
+				{Amount: Amount{Value: 50, Currency: Toman}, StartIndex: 0, EndIndex: 7, Text: "۵۰ تومان"},
+				{Amount: Amount{Value: 500, Currency: Rial}, StartIndex: 12, EndIndex: 19, Text: "۵۰۰ ریال"},
+			},
+		},
+		{
+			name:    "number_without_unit",
+			input:   "۳ نفر",
+			amounts: []DetectedAmount{},
+		},
+		{
+			name:    "longer_word",
+			input:   "۱۰۰ ریالی",
+			amounts: []DetectedAmount{},
+		},
+	}
+
+	detector := &PersianAmountDetector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detector.DetectAmounts(tt.input); !reflect.DeepEqual(got, tt.amounts) {
+				t.Errorf("DetectAmounts() = %v, want %v", got, tt.amounts)
+			}
+		})
+	}
+}
+
+func TestAmount_Convert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Amount
+		to     Currency
+		want   Amount
+		wantOk bool
+	}{
+		{name: "toman_to_rial", amount: Amount{Value: 25000, Currency: Toman}, to: Rial, want: Amount{Value: 250000, Currency: Rial}, wantOk: true},
+		{name: "rial_to_toman", amount: Amount{Value: 250000, Currency: Rial}, to: Toman, want: Amount{Value: 25000, Currency: Toman}, wantOk: true},
+		{name: "same_currency", amount: Amount{Value: 7, Currency: Toman}, to: Toman, want: Amount{Value: 7, Currency: Toman}, wantOk: true},
+		{name: "rials_below_a_toman", amount: Amount{Value: 25, Currency: Rial}, to: Toman, wantOk: false},
+		{name: "rials_overflow", amount: Amount{Value: math.MaxInt64/10 + 1, Currency: Toman}, to: Rial, wantOk: false},
+		{name: "largest_toman_in_rials", amount: Amount{Value: math.MaxInt64 / 10, Currency: Toman}, to: Rial, want: Amount{Value: math.MaxInt64 / 10 * 10, Currency: Rial}, wantOk: true},
+		{name: "negative_rials_overflow", amount: Amount{Value: math.MinInt64/10 - 1, Currency: Toman}, to: Rial, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.amount.Convert(tt.to)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("Convert() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestAmount_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Amount
+		opts   []numword.Option
+		words  string
+		digits string
+	}{
+		{name: "toman", amount: Amount{Value: 25000, Currency: Toman}, words: "بیست و پنج هزار تومان", digits: "۲۵٬۰۰۰ تومان"},
+		{name: "rial", amount: Amount{Value: 2500000, Currency: Rial}, words: "دو میلیون پانصد هزار ریال", digits: "۲٬۵۰۰٬۰۰۰ ریال"},
+		{name: "small", amount: Amount{Value: 500, Currency: Toman}, words: "پانصد تومان", digits: "۵۰۰ تومان"},
+		{name: "informal", amount: Amount{Value: 600, Currency: Toman}, opts: []numword.Option{numword.Informal()}, words: "شیشصد تومان", digits: "۶۰۰ تومان"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Words(tt.opts...); got != tt.words {
+				t.Errorf("Words() = %v, want %v", got, tt.words)
+			}
+			if got := tt.amount.Digits(); got != tt.digits {
+				t.Errorf("Digits() = %v, want %v", got, tt.digits)
+			}
+		})
+	}
+}
+
+func TestGroupDigits(t *testing.T) {
+	tests := map[int64]string{
+		0:        "۰",
+		999:      "۹۹۹",
+		1000:     "۱٬۰۰۰",
+		-1234567: "-۱٬۲۳۴٬۵۶۷",
+	}
+	for input, want := range tests {
+		if got := GroupDigits(input); got != want {
+			t.Errorf("GroupDigits(%v) = %v, want %v", input, got, want)
+		}
+	}
+}
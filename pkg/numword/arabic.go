@@ -0,0 +1,106 @@
+package numword
+
+import "strings"
+
+// arabicScale holds the forms a scale word takes after the number of its group:
+// one, two, three to ten, and eleven to ninety-nine. Other groups take the one form.
+type arabicScale struct {
+	one, two, few, many string
+}
+
+var (
+	arabicMegas = []arabicScale{
+		{},
+		{"ألف", "ألفان", "آلاف", "ألفا"},
+		{"مليون", "مليونان", "ملايين", "مليونا"},
+		{"مليار", "ملياران", "مليارات", "مليارا"},
+		{"تريليون", "تريليونان", "تريليونات", "تريليونا"},
+		{"كوادريليون", "كوادريليونان", "كوادريليونات", "كوادريليونا"},
+		{"كوينتليون", "كوينتليونان", "كوينتليونات", "كوينتليونا"},
+	}
+	arabicUnits    = []string{"", "واحد", "اثنان", "ثلاثة", "أربعة", "خمسة", "ستة", "سبعة", "ثمانية", "تسعة"}
+	arabicTeens    = []string{"عشرة", "أحد عشر", "اثنا عشر", "ثلاثة عشر", "أربعة عشر", "خمسة عشر", "ستة عشر", "سبعة عشر", "ثمانية عشر", "تسعة عشر"}
+	arabicTens     = []string{"", "", "عشرون", "ثلاثون", "أربعون", "خمسون", "ستون", "سبعون", "ثمانون", "تسعون"}
+	arabicHundreds = []string{"", "مائة", "مائتان", "ثلاثمائة", "أربعمائة", "خمسمائة", "ستمائة", "سبعمائة", "ثمانمائة", "تسعمائة"}
+)
+
+const (
+	arabicAnd = " و" // the conjunction is written joined to the next word
+	// arabicTwoHundredBeforeScale is "مائتان" when a scale word follows it, as in "مائتا ألف"
+	arabicTwoHundredBeforeScale = "مائتا"
+)
+
+// Arabic spells n with Arabic words, as in "مائة وثلاثة وعشرون ألفا وخمسمائة وسبعة وستون"
+func Arabic(n int64) string {
+	switch {
+	case n == 0:
+		return "صفر"
+	case n < 0:
+		return "سالب " + arabicPositive(uint64(-(n+1))+1)
+	}
+	return arabicPositive(uint64(n))
+}
+
+// arabicPositive spells n > 0 one group of three digits at a time
+func arabicPositive(n uint64) string {
+	var groups []int
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, int(n%1000))
+	}
+
+	words := make([]string, 0, len(groups))
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] > 0 {
+			words = append(words, arabicGroupWithScale(groups[i], arabicMegas[i]))
+		}
+	}
+	return strings.Join(words, arabicAnd)
+}
+
+// arabicGroupWithScale spells a group from 1 to 999 followed by its scale word.
+// The scale word agrees with the last two digits of the group, as in "ألفان", "مائة وثلاثة آلاف" or "أحد عشر ألفا".
+func arabicGroupWithScale(group int, scale arabicScale) string {
+	if scale.one == "" {
+		return arabicGroup(group)
+	}
+	h, rest := group/100, group%100
+
+	var hundreds string
+	if h > 0 {
+		hundreds = arabicHundreds[h] + arabicAnd
+	}
+	switch {
+	case rest == 0 && h == 2:
+		return arabicTwoHundredBeforeScale + " " + scale.one
+	case rest == 0:
+		return arabicHundreds[h] + " " + scale.one
+	case rest == 1:
+		return hundreds + scale.one
+	case rest == 2:
+		return hundreds + scale.two
+	case rest <= 10:
+		return arabicGroup(group) + " " + scale.few
+	}
+	return arabicGroup(group) + " " + scale.many
+}
+
+// arabicGroup spells a number from 1 to 999, with the units before the tens as in "خمسة وعشرون"
+func arabicGroup(group int) string {
+	h, t, u := group/100, (group/10)%10, group%10
+
+	parts := make([]string, 0, 3)
+	if h > 0 {
+		parts = append(parts, arabicHundreds[h])
+	}
+	switch {
+	case t == 1:
+		parts = append(parts, arabicTeens[u])
+	case t > 1 && u > 0:
+		parts = append(parts, arabicUnits[u]+arabicAnd+arabicTens[t])
+	case t > 1:
+		parts = append(parts, arabicTens[t])
+	case u > 0:
+		parts = append(parts, arabicUnits[u])
+	}
+	return strings.Join(parts, arabicAnd)
+}
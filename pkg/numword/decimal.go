@@ -0,0 +1,99 @@
+package numword
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const decimalPoint = "ممیز"
+
+var (
+	ErrInvalidDecimal  = errors.New("numword: invalid decimal number")
+	ErrZeroDenominator = errors.New("numword: zero denominator")
+)
+
+// persianDigits rewrites Persian digits and the Persian decimal separator in English
+var persianDigits = strings.NewReplacer(
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4", "۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9", "٫", ".",
+)
+
+// Decimal spells a decimal number written with English or Persian digits, as in "2.5" to "دو ممیز پنج دهم".
+// The digits after the point are read as written, so "2.50" is "دو ممیز پنجاه صدم".
+func Decimal(s string, opts ...Option) (string, error) {
+	c := newConfig(opts)
+
+	s = persianDigits.Replace(s)
+	sign := ""
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		sign, s = negative+" ", rest
+	}
+	intPart, fracPart, hasPoint := strings.Cut(s, ".")
+	if (intPart == "" && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) || (hasPoint && fracPart == "") {
+		return "", ErrInvalidDecimal
+	}
+
+	integer, _ := new(big.Int).SetString("0"+intPart, 10)
+	fraction, _ := new(big.Int).SetString("0"+fracPart, 10)
+	if fraction.Sign() == 0 {
+		if integer.Sign() == 0 {
+			return zero, nil
+		}
+		return sign + c.cardinal(integer), nil
+	}
+
+	return sign + c.cardinal(integer) + " " + decimalPoint + " " + c.cardinal(fraction) + " " + powerOfTenOrdinal(len(fracPart)), nil
+}
+
+// Fraction spells numerator/denominator, as in "سه چهارم".
+// A fraction larger than one is spelled with its whole part, as in "یک و سه چهارم".
+func Fraction(numerator, denominator int64, opts ...Option) (string, error) {
+	if denominator == 0 {
+		return "", ErrZeroDenominator
+	}
+	c := newConfig(opts)
+
+	num, den := big.NewInt(numerator), big.NewInt(denominator)
+	sign := ""
+	if num.Sign()*den.Sign() < 0 {
+		sign = negative + " "
+	}
+	num.Abs(num)
+	den.Abs(den)
+
+	whole, rest := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rest.Sign() == 0 {
+		return sign + c.cardinal(whole), nil
+	}
+
+	words := c.cardinal(rest) + " " + ordinal(c.cardinal(den))
+	if whole.Sign() > 0 {
+		words = c.cardinal(whole) + and + words
+	}
+	return sign + words, nil
+}
+
+// powerOfTenOrdinal spells the ordinal of 10^exponent without a leading "یک", as in "دهم", "صدم" or "ده هزارم"
+func powerOfTenOrdinal(exponent int) string {
+	words := make([]string, 0, 2)
+	switch exponent % 3 {
+	case 1:
+		words = append(words, tens[1])
+	case 2:
+		words = append(words, hundreds[1])
+	}
+	if exponent >= 3 {
+		scale := new(big.Int).Exp(big.NewInt(1000), big.NewInt(int64(exponent/3)), nil)
+		words = append(words, strings.TrimPrefix(spellPositive(scale), units[1]+" "))
+	}
+	return ordinal(strings.Join(words, " "))
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
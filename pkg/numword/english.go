@@ -0,0 +1,61 @@
+package numword
+
+import "strings"
+
+var (
+	englishMegas = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+	englishUnits = []string{"", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+	englishTeens = []string{"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+)
+
+// English spells n with English words, as in "one hundred twenty-three thousand five hundred sixty-seven"
+func English(n int64) string {
+	switch {
+	case n == 0:
+		return "zero"
+	case n < 0:
+		return "minus " + englishPositive(uint64(-(n+1))+1)
+	}
+	return englishPositive(uint64(n))
+}
+
+// englishPositive spells n > 0 one group of three digits at a time
+func englishPositive(n uint64) string {
+	var groups []int
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, int(n%1000))
+	}
+
+	words := make([]string, 0, 2*len(groups))
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] > 0 {
+			words = append(words, englishGroup(groups[i]))
+			if englishMegas[i] != "" {
+				words = append(words, englishMegas[i])
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// englishGroup spells a number from 1 to 999
+func englishGroup(group int) string {
+	h, t, u := group/100, (group/10)%10, group%10
+
+	parts := make([]string, 0, 3)
+	if h > 0 {
+		parts = append(parts, englishUnits[h], "hundred")
+	}
+	switch {
+	case t == 1:
+		parts = append(parts, englishTeens[u])
+	case t > 1 && u > 0:
+		parts = append(parts, englishTens[t]+"-"+englishUnits[u])
+	case t > 1:
+		parts = append(parts, englishTens[t])
+	case u > 0:
+		parts = append(parts, englishUnits[u])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,146 @@
+// Package numword spells numbers with Persian words: cardinals, ordinals, decimals and fractions.
+// English and Arabic cardinals are spelled by English and Arabic.
+package numword
+
+import (
+	"math/big"
+	"strings"
+)
+
+var (
+	megas    = []string{"", "هزار", "میلیون", "میلیارد", "بیلیون", "بیلیارد", "تریلیون", "تریلیارد"}
+	units    = []string{"", "یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه"}
+	tens     = []string{"", "ده", "بیست", "سی", "چهل", "پنجاه", "شصت", "هفتاد", "هشتاد", "نود"}
+	teens    = []string{"ده", "یازده", "دوازده", "سیزده", "چهارده", "پانزده", "شانزده", "هفده", "هجده", "نوزده"}
+	hundreds = []string{"", "صد", "دویست", "سیصد", "چهارصد", "پانصد", "ششصد", "هفتصد", "هشتصد", "نهصد"}
+
+	// informalWords maps number words to the way they are said in the informal register
+	informalWords = map[string]string{
+		"چهار": "چار", "شش": "شیش", "چهارده": "چارده", "پانزده": "پونزده", "شانزده": "شونزده",
+		"هفده": "هیفده", "هجده": "هیجده", "چهارصد": "چارصد", "پانصد": "پونصد", "ششصد": "شیشصد",
+		"هفتصد": "هفصد", "هشتصد": "هشصد",
+	}
+
+	// largestMega is the value of the last word of megas, larger numbers repeat it as in "هزار تریلیارد"
+	largestMega = new(big.Int).Exp(big.NewInt(1000), big.NewInt(int64(len(megas)-1)), nil)
+)
+
+const (
+	zero     = "صفر"
+	negative = "منفی"
+	and      = " و "
+)
+
+// Option changes how numbers are spelled
+type Option func(*config)
+
+type config struct {
+	informal bool
+}
+
+// Informal spells numbers in the informal register, as in "شیش" and "پونصد" instead of "شش" and "پانصد"
+func Informal() Option {
+	return func(c *config) {
+		c.informal = true
+	}
+}
+
+func newConfig(opts []Option) config {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// Scales returns the scale words, the word at index i stands for 1000^i
+func Scales() []string {
+	return append([]string(nil), megas...)
+}
+
+// Cardinal spells n, as in "صد و بیست و سه هزار پانصد و شصت و هفت"
+func Cardinal(n int64, opts ...Option) string {
+	return newConfig(opts).cardinal(big.NewInt(n))
+}
+
+// CardinalBig spells n, which may be larger than any int64
+func CardinalBig(n *big.Int, opts ...Option) string {
+	return newConfig(opts).cardinal(n)
+}
+
+func (c config) cardinal(n *big.Int) string {
+	switch n.Sign() {
+	case 0:
+		return zero
+	case -1:
+		return negative + " " + c.cardinal(new(big.Int).Neg(n))
+	}
+	return c.informalize(spellPositive(n))
+}
+
+// spellPositive spells n > 0 one group of three digits at a time
+func spellPositive(n *big.Int) string {
+	// beyond the last scale word the number of "تریلیارد"s is spelled as a number itself
+	if n.Cmp(new(big.Int).Mul(largestMega, big.NewInt(1000))) >= 0 {
+		high, low := new(big.Int).QuoRem(n, largestMega, new(big.Int))
+		words := spellPositive(high) + " " + megas[len(megas)-1]
+		if low.Sign() > 0 {
+			words += " " + spellPositive(low)
+		}
+		return words
+	}
+
+	var groups []int
+	thousand := big.NewInt(1000)
+	for rest, group := new(big.Int).Set(n), new(big.Int); rest.Sign() > 0; {
+		rest.QuoRem(rest, thousand, group)
+		groups = append(groups, int(group.Int64()))
+	}
+
+	words := make([]string, 0, 2*len(groups))
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] > 0 {
+			words = append(words, spellGroup(groups[i]))
+			if megas[i] != "" {
+				words = append(words, megas[i])
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// spellGroup spells a number from 1 to 999
+func spellGroup(group int) string {
+	h, t, u := group/100, (group/10)%10, group%10
+
+	parts := make([]string, 0, 3)
+	if h > 0 {
+		parts = append(parts, hundreds[h])
+	}
+	switch {
+	case t == 1:
+		parts = append(parts, teens[u])
+	case t > 1:
+		parts = append(parts, tens[t])
+		if u > 0 {
+			parts = append(parts, units[u])
+		}
+	case u > 0:
+		parts = append(parts, units[u])
+	}
+	return strings.Join(parts, and)
+}
+
+// informalize replaces every word of the spelled number with its informal form when asked to
+func (c config) informalize(words string) string {
+	if !c.informal {
+		return words
+	}
+	fields := strings.Split(words, " ")
+	for i, word := range fields {
+		if informal, ok := informalWords[word]; ok {
+			fields[i] = informal
+		}
+	}
+	return strings.Join(fields, " ")
+}
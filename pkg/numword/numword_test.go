@@ -0,0 +1,215 @@
+package numword
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCardinal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		opts  []Option
+		want  string
+	}{
+		{name: "zero", input: 0, want: "صفر"},
+		{name: "unit", input: 3, want: "سه"},
+		{name: "tens", input: 23, want: "بیست و سه"},
+		{name: "hundreds", input: 123, want: "صد و بیست و سه"},
+		{name: "thousands", input: 1235, want: "یک هزار دویست و سی و پنج"},
+		{name: "scales", input: 2_500_000, want: "دو میلیون پانصد هزار"},
+		{name: "negative", input: -16, want: "منفی شانزده"},
+		{
+			name:  "min_int64",
+			input: math.MinInt64,
+			want:  "منفی نه تریلیون دویست و بیست و سه بیلیارد سیصد و هفتاد و دو بیلیون سی و شش میلیارد هشتصد و پنجاه و چهار میلیون هفتصد و هفتاد و پنج هزار هشتصد و هشت",
+		},
+		{name: "informal", input: 656, opts: []Option{Informal()}, want: "شیشصد و پنجاه و شیش"},
+		{name: "informal_teens", input: 15_418, opts: []Option{Informal()}, want: "پونزده هزار چارصد و هیجده"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cardinal(tt.input, tt.opts...); got != tt.want {
+				t.Errorf("Cardinal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardinalBig(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "fits_in_int64", input: "1000000", want: "یک میلیون"},
+		{name: "largest_scale", input: "2000000000000000000000", want: "دو تریلیارد"},
+		{name: "beyond_largest_scale", input: "1002000000000000000000005", want: "یک هزار دو تریلیارد پنج"},
+		{name: "negative", input: "-1000000000000000000000000", want: "منفی یک هزار تریلیارد"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, _ := new(big.Int).SetString(tt.input, 10)
+			if got := CardinalBig(n); got != tt.want {
+				t.Errorf("CardinalBig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      int64
+		ordinal    string
+		prenominal string
+	}{
+		{name: "first", input: 1, ordinal: "یکم", prenominal: "یکمین"},
+		{name: "third", input: 3, ordinal: "سوم", prenominal: "سومین"},
+		{name: "thirtieth", input: 30, ordinal: "سی‌ام", prenominal: "سی‌امین"},
+		{name: "compound", input: 21, ordinal: "بیست و یکم", prenominal: "بیست و یکمین"},
+		{name: "compound_third", input: 123, ordinal: "صد و بیست و سوم", prenominal: "صد و بیست و سومین"},
+		{name: "scale", input: 2000, ordinal: "دو هزارم", prenominal: "دو هزارمین"},
+		{name: "single_scale", input: 1000, ordinal: "هزارم", prenominal: "هزارمین"},
+		{name: "after_scale", input: 1001, ordinal: "یک هزار یکم", prenominal: "یک هزار یکمین"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ordinal(tt.input); got != tt.ordinal {
+				t.Errorf("Ordinal() = %v, want %v", got, tt.ordinal)
+			}
+			if got := PrenominalOrdinal(tt.input); got != tt.prenominal {
+				t.Errorf("PrenominalOrdinal() = %v, want %v", got, tt.prenominal)
+			}
+			if got := OrdinalBig(big.NewInt(tt.input)); got != tt.ordinal {
+				t.Errorf("OrdinalBig() = %v, want %v", got, tt.ordinal)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    []Option
+		want    string
+		wantErr error
+	}{
+		{name: "tenths", input: "2.5", want: "دو ممیز پنج دهم"},
+		{name: "hundredths", input: "3.25", want: "سه ممیز بیست و پنج صدم"},
+		{name: "trailing_zero_is_read", input: "2.50", want: "دو ممیز پنجاه صدم"},
+		{name: "ten_thousandths", input: "0.0005", want: "صفر ممیز پنج ده هزارم"},
+		{name: "millionths", input: "1.000001", want: "یک ممیز یک میلیونم"},
+		{name: "persian_digits", input: "۱۲٫۵", want: "دوازده ممیز پنج دهم"},
+		{name: "negative", input: "-0.5", want: "منفی صفر ممیز پنج دهم"},
+		{name: "integer", input: "16", want: "شانزده"},
+		{name: "zero_fraction", input: "16.0", want: "شانزده"},
+		{name: "informal", input: "6.6", opts: []Option{Informal()}, want: "شیش ممیز شیش دهم"},
+		{name: "empty", input: "", wantErr: ErrInvalidDecimal},
+		{name: "no_fraction_digits", input: "2.", wantErr: ErrInvalidDecimal},
+		{name: "letters", input: "2.5e3", wantErr: ErrInvalidDecimal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decimal(tt.input, tt.opts...)
+			if err != tt.wantErr {
+				t.Fatalf("Decimal() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   int64
+		denominator int64
+		want        string
+		wantErr     error
+	}{
+		{name: "quarter", numerator: 1, denominator: 4, want: "یک چهارم"},
+		{name: "two_thirds", numerator: 2, denominator: 3, want: "دو سوم"},
+		{name: "as_written", numerator: 2, denominator: 4, want: "دو چهارم"},
+		{name: "thirtieth", numerator: 7, denominator: 30, want: "هفت سی‌ام"},
+		{name: "thousandth", numerator: 1, denominator: 1000, want: "یک هزارم"},
+		{name: "mixed", numerator: 7, denominator: 4, want: "یک و سه چهارم"},
+		{name: "whole", numerator: 8, denominator: 4, want: "دو"},
+		{name: "negative", numerator: 1, denominator: -2, want: "منفی یک دوم"},
+		{name: "zero_denominator", numerator: 1, denominator: 0, wantErr: ErrZeroDenominator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Fraction(tt.numerator, tt.denominator)
+			if err != tt.wantErr {
+				t.Fatalf("Fraction() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Fraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnglish(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		want  string
+	}{
+		{name: "zero", input: 0, want: "zero"},
+		{name: "teen", input: 15, want: "fifteen"},
+		{name: "tens", input: 40, want: "forty"},
+		{name: "hyphenated_tens", input: 23, want: "twenty-three"},
+		{name: "hundreds", input: 110, want: "one hundred ten"},
+		{name: "thousands", input: 1235, want: "one thousand two hundred thirty-five"},
+		{name: "scales", input: 2_500_000, want: "two million five hundred thousand"},
+		{name: "negative", input: -16, want: "minus sixteen"},
+		{
+			name:  "min_int64",
+			input: math.MinInt64,
+			want:  "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := English(tt.input); got != tt.want {
+				t.Errorf("English() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArabic(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		want  string
+	}{
+		{name: "zero", input: 0, want: "صفر"},
+		{name: "unit", input: 3, want: "ثلاثة"},
+		{name: "teen", input: 12, want: "اثنا عشر"},
+		{name: "units_before_tens", input: 25, want: "خمسة وعشرون"},
+		{name: "hundreds", input: 110, want: "مائة وعشرة"},
+		{name: "thousand", input: 1235, want: "ألف ومائتان وخمسة وثلاثون"},
+		{name: "two_thousand", input: 2000, want: "ألفان"},
+		{name: "few_thousands", input: 3000, want: "ثلاثة آلاف"},
+		{name: "many_thousands", input: 15_000, want: "خمسة عشر ألفا"},
+		{name: "hundreds_of_thousands", input: 300_000, want: "ثلاثمائة ألف"},
+		{name: "two_hundred_thousand", input: 200_000, want: "مائتا ألف"},
+		{name: "hundred_and_few_thousands", input: 103_000, want: "مائة وثلاثة آلاف"},
+		{name: "millions", input: 2_500_000, want: "مليونان وخمسمائة ألف"},
+		{name: "negative", input: -7, want: "سالب سبعة"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Arabic(tt.input); got != tt.want {
+				t.Errorf("Arabic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package numword
+
+import (
+	"math/big"
+	"strings"
+)
+
+const halfSpace = "\u200c"
+
+// irregularOrdinals are the ordinals that do not just add "م" to the last word
+var irregularOrdinals = map[string]string{
+	"سه": "سوم",
+	"سی": "سی" + halfSpace + "ام",
+}
+
+// Ordinal spells n as an ordinal that follows its noun, as in "روز بیست و یکم"
+func Ordinal(n int64, opts ...Option) string {
+	return ordinal(newConfig(opts).cardinal(big.NewInt(n)))
+}
+
+// OrdinalBig is Ordinal for numbers that may be larger than any int64
+func OrdinalBig(n *big.Int, opts ...Option) string {
+	return ordinal(newConfig(opts).cardinal(n))
+}
+
+// PrenominalOrdinal spells n as an ordinal that comes before its noun, as in "بیست و یکمین روز"
+func PrenominalOrdinal(n int64, opts ...Option) string {
+	return Ordinal(n, opts...) + "ین"
+}
+
+// PrenominalOrdinalBig is PrenominalOrdinal for numbers that may be larger than any int64
+func PrenominalOrdinalBig(n *big.Int, opts ...Option) string {
+	return OrdinalBig(n, opts...) + "ین"
+}
+
+// ordinal turns the last word of a spelled cardinal into an ordinal.
+// A single scale loses its "یک", since "یک هزارم" is a fraction while "هزارم" is an ordinal.
+func ordinal(cardinal string) string {
+	if scale, ok := strings.CutPrefix(cardinal, units[1]+" "); ok && !strings.Contains(scale, " ") {
+		cardinal = scale
+	}
+	head, last := "", cardinal
+	if i := strings.LastIndex(cardinal, " "); i >= 0 {
+		head, last = cardinal[:i+1], cardinal[i+1:]
+	}
+	if irregular, ok := irregularOrdinals[last]; ok {
+		return head + irregular
+	}
+	return head + last + "م"
+}
@@ -0,0 +1,55 @@
+package offset
+
+// Span is a half-open range [Start, End) of rune indices in the original input.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Union returns the smallest span covering both s and other.
+func (s Span) Union(other Span) Span {
+	return Span{Start: min(s.Start, other.Start), End: max(s.End, other.End)}
+}
+
+// Map maps every rune of a normalized output back to the span of the input it came from.
+// Inserted runes (for example the space added after a special yeh) point at the rune that produced them,
+// and runes that replace a longer run (a collapsed space run or a spelled number) point at the whole run.
+type Map struct {
+	spans []Span
+}
+
+// NewMap creates a Map from one span per output rune.
+func NewMap(spans []Span) Map {
+	return Map{spans: spans}
+}
+
+// Len returns the number of output runes covered by the map.
+func (m Map) Len() int {
+	return len(m.spans)
+}
+
+// Span returns the input span of the i-th output rune.
+func (m Map) Span(i int) Span {
+	return m.spans[i]
+}
+
+// Range returns the input span covering output runes [start, end).
+// An empty range maps to an empty span at the input position of start.
+func (m Map) Range(start, end int) Span {
+	if start >= end {
+		if start < len(m.spans) {
+			return Span{Start: m.spans[start].Start, End: m.spans[start].Start}
+		}
+		if len(m.spans) > 0 {
+			last := m.spans[len(m.spans)-1].End
+			return Span{Start: last, End: last}
+		}
+		return Span{}
+	}
+
+	span := m.spans[start]
+	for _, s := range m.spans[start+1 : end] {
+		span = span.Union(s)
+	}
+	return span
+}
@@ -0,0 +1,80 @@
+package options
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// Dictionary holds user-defined replacements
+type Dictionary struct {
+	// Runes replaces single characters. It applies while letters are unified and takes precedence
+	// over the built-in rules, so it also sees the characters that rule would change.
+	Runes map[rune]rune
+	// Phrases replaces whole words or phrases, such as "خ." with "خیابان". Keys match the text after its letters
+	// are unified, so "كوچه" matches "کوچه", a space matches any run of spaces, and the replacements are
+	// left as they are by the steps after it.
+	Phrases map[string]string
+}
+
+// dictionarySeparator separates the two sides of a rule in a dictionary file
+const dictionarySeparator = "=>"
+
+// ReadDictionary reads a dictionary in the text format:
+//
+//	# comments start with "#"
+//	[runes]
+//	ك => ک
+//	[phrases]
+//	خ. => خیابان
+//	اسنپ فود => اسنپ‌فود
+//
+// Rules before any section are phrases.
+func ReadDictionary(r io.Reader) (Dictionary, error) {
+	dict := Dictionary{Runes: map[rune]rune{}, Phrases: map[string]string{}}
+	section := "phrases"
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+			continue
+		case text == "[runes]" || text == "[phrases]":
+			section = strings.Trim(text, "[]")
+			continue
+		}
+
+		from, to, ok := strings.Cut(text, dictionarySeparator)
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" {
+			return Dictionary{}, fmt.Errorf("dictionary line %d: want \"from %s to\", got %q", line, dictionarySeparator, text)
+		}
+		if section == "phrases" {
+			dict.Phrases[from] = to
+			continue
+		}
+		if utf8.RuneCountInString(from) != 1 || utf8.RuneCountInString(to) != 1 {
+			return Dictionary{}, fmt.Errorf("dictionary line %d: rune rules map one character to one character, got %q", line, text)
+		}
+		fromRune, _ := utf8.DecodeRuneInString(from)
+		toRune, _ := utf8.DecodeRuneInString(to)
+		dict.Runes[fromRune] = toRune
+	}
+	if err := scanner.Err(); err != nil {
+		return Dictionary{}, err
+	}
+	return dict, nil
+}
+
+// LoadDictionary reads the dictionary file at path, see ReadDictionary for its format
+func LoadDictionary(path string) (Dictionary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Dictionary{}, err
+	}
+	defer f.Close()
+	return ReadDictionary(f)
+}
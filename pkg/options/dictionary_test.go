@@ -0,0 +1,51 @@
+package options
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadDictionary(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Dictionary
+		wantErr bool
+	}{
+		{
+			name: "sections",
+			input: `# brands
+اسنپ فود => اسنپ‌فود
+
+[runes]
+ك => ک
+[phrases]
+  خ.   =>   خیابان  
+`,
+			want: Dictionary{
+				Runes:   map[rune]rune{'ك': 'ک'},
+				Phrases: map[string]string{"اسنپ فود": "اسنپ‌فود", "خ.": "خیابان"},
+			},
+		},
+		{
+			name:  "empty replacement",
+			input: "لطفا =>",
+			want:  Dictionary{Runes: map[rune]rune{}, Phrases: map[string]string{"لطفا": ""}},
+		},
+		{name: "missing separator", input: "خ. خیابان", wantErr: true},
+		{name: "empty key", input: "=> خیابان", wantErr: true},
+		{name: "rune rule with a word", input: "[runes]\nك => کاف", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadDictionary(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadDictionary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadDictionary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
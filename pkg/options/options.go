@@ -1,14 +1,29 @@
 package options
 
+import (
+	"regexp"
+
+	"github.com/snapp-incubator/seperno/pkg/offset"
+)
+
 var DefaultOptions = NormalizerOptions{
 	ConvertHalfSpaceToSpace: false,
+	HalfSpaceFixer:          false,
 	URLRemover:              false,
 	OuterSpaceRemover:       false,
 	SpaceCombiner:           false,
 	NormalizePunctuations:   false,
 	EndsWithEndOfLineChar:   false,
 	IntToWord:               false,
+	WordToInt:               false,
 	ConvertNumberLang:       LanguageEn,
+	IntToWordLang:           LanguageFa,
+	Phone:                   false,
+	PhoneFormat:             PhoneFormatE164,
+	PIIMasker:               false,
+	Case:                    CaseLower,
+	Diacritics:              DiacriticsStrip,
+	Script:                  ScriptPersian,
 }
 
 type Language string
@@ -19,15 +34,183 @@ const (
 	LanguageEn Language = "en"
 )
 
+// CaseMode is what the normalizer does with upper and lower case letters
+type CaseMode string
+
+const (
+	CaseLower    CaseMode = "lower"    // "Snapp" becomes "snapp"
+	CaseFold     CaseMode = "fold"     // simple case folding with "ß" and the Latin ligatures expanded, "Straße" becomes "strasse"
+	CasePreserve CaseMode = "preserve" // letters keep their case
+)
+
+// DiacriticsPolicy is what the normalizer does with diacritics (اعراب) such as fatheh, tashdid or tanvin
+type DiacriticsPolicy string
+
+const (
+	DiacriticsStrip             DiacriticsPolicy = "strip"               // remove every diacritic
+	DiacriticsKeep              DiacriticsPolicy = "keep"                // keep the diacritics, as in "کِتابٌ"
+	DiacriticsKeepTashdidTanvin DiacriticsPolicy = "keep_tashdid_tanvin" // keep tashdid and tanvin only, as in "کتابٌ"
+)
+
+// ScriptProfile is the language whose letters the normalizer keeps. Letters of other languages written
+// with the Arabic script are folded into the closest letters of that language.
+type ScriptProfile string
+
+const (
+	ScriptPersian ScriptProfile = "persian" // fold every letter into Persian ones
+	ScriptSorani  ScriptProfile = "sorani"  // keep the Kurdish (Sorani) letters ڕ ڵ ۆ ێ ە ھ ڤ
+	ScriptPashto  ScriptProfile = "pashto"  // keep the Pashto letters ټ ډ ړ ږ ښ ګ ڼ ځ څ ې ۍ ئ ي
+	ScriptUrdu    ScriptProfile = "urdu"    // keep the Urdu letters ٹ ڈ ڑ ں ھ ہ ۂ ۃ ے ۓ
+	ScriptDari    ScriptProfile = "dari"    // keep the Pashto consonants ټ ډ ړ ږ ښ ګ ڼ ځ څ of Afghan names
+)
+
+// PIIKind is a kind of personal data the PII masker finds
+type PIIKind string
+
+const (
+	PIIMobile     PIIKind = "mobile"      // "09123456789"
+	PIINationalID PIIKind = "national_id" // a کد ملی with a valid check digit, as in "0499370899"
+	PIICard       PIIKind = "card"        // a 16-digit bank card number that passes the Luhn check
+	PIISheba      PIIKind = "sheba"       // an IR Sheba (IBAN) number that passes the mod-97 check
+)
+
+// DefaultPIIPlaceholders are the tokens the PII masker writes instead of each kind of personal data
+var DefaultPIIPlaceholders = map[PIIKind]string{
+	PIIMobile:     "[MOBILE]",
+	PIINationalID: "[NATIONAL_ID]",
+	PIICard:       "[CARD]",
+	PIISheba:      "[SHEBA]",
+}
+
+// PII is personal data found in a text.
+// Start and End are the rune indices of its first and last rune.
+type PII struct {
+	Start int
+	End   int
+	Kind  PIIKind
+}
+
+// MaskedPII is personal data the PII masker replaced
+type MaskedPII struct {
+	Kind PIIKind
+	// Text is the masked text as the masker saw it, after the steps that ran before it
+	Text string
+	// Span is the part of the original input that was masked
+	Span offset.Span
+}
+
+// EntityKind is a kind of entity the entity handler finds
+type EntityKind string
+
+const (
+	EntityURL     EntityKind = "url"     // "https://snapp.ir/ride", "www.snapp.ir" or "snapp.ir/ride"
+	EntityEmail   EntityKind = "email"   // "info@snapp.ir"
+	EntityMention EntityKind = "mention" // "@snapp"
+	EntityHashtag EntityKind = "hashtag" // "#اسنپ"
+)
+
+// EntityMode is what the entity handler does with an entity
+type EntityMode string
+
+const (
+	EntityRemove  EntityMode = "remove"  // remove the entity
+	EntityReplace EntityMode = "replace" // write the token of its kind instead of the entity
+	EntityExtract EntityMode = "extract" // keep the entity as it was written and report it
+)
+
+// DefaultEntityTokens are the tokens the entity handler writes instead of each kind of entity
+var DefaultEntityTokens = map[EntityKind]string{
+	EntityURL:     "[URL]",
+	EntityEmail:   "[EMAIL]",
+	EntityMention: "[MENTION]",
+	EntityHashtag: "[HASHTAG]",
+}
+
+// Entity is a URL, e-mail, mention or hashtag the entity handler found
+type Entity struct {
+	Kind EntityKind
+	Mode EntityMode
+	// Text is the entity as it was written in the input
+	Text string
+	// Span is the part of the original input the entity came from
+	Span offset.Span
+}
+
+// PhoneFormat is the form phone numbers are written in
+type PhoneFormat string
+
+const (
+	PhoneFormatE164     PhoneFormat = "e164"     // "+989123456789"
+	PhoneFormatNational PhoneFormat = "national" // "09123456789"
+)
+
+// NumberWord is a number written with words found in a text.
+// Start and End are the rune indices of its first and last rune, and Digits is its value in English digits.
+type NumberWord struct {
+	Start  int
+	End    int
+	Digits string
+}
+
+// PhoneNumber is a phone number found in a text.
+// Start and End are the rune indices of its first and last rune, and E164 and National are its canonical forms
+// in English digits, as in "+989123456789" and "09123456789".
+type PhoneNumber struct {
+	Start    int
+	End      int
+	E164     string
+	National string
+}
+
 type NormalizerOptions struct {
 	ConvertHalfSpaceToSpace bool
-	URLRemover              bool
-	OuterSpaceRemover       bool
-	SpaceCombiner           bool
-	NormalizePunctuations   bool
-	EndsWithEndOfLineChar   bool
-	IntToWord               bool
-	ConvertNumberLang       Language
+	// HalfSpaceFixer writes a half space instead of the space between a word and its prefix or suffix
+	HalfSpaceFixer        bool
+	URLRemover            bool
+	OuterSpaceRemover     bool
+	SpaceCombiner         bool
+	NormalizePunctuations bool
+	EndsWithEndOfLineChar bool
+	IntToWord             bool
+	WordToInt             bool
+	ConvertNumberLang     Language
+	// Case is what happens to the case of letters, they are lowercased when it is empty
+	Case CaseMode
+	// Diacritics is what happens to diacritics, they are removed when it is empty
+	Diacritics DiacriticsPolicy
+	// Script is the language whose letters are kept, Persian when it is empty
+	Script ScriptProfile
+	// IntToWordLang is the language IntToWord spells numbers in, Persian when it is empty
+	IntToWordLang Language
+	Phone         bool
+	PhoneFormat   PhoneFormat
+	PIIMasker     bool
+	// PIIPlaceholders overrides DefaultPIIPlaceholders for the kinds it has
+	PIIPlaceholders map[PIIKind]string
+	// PIIReport is called with every piece of personal data the PII masker replaces, when it is not nil
+	PIIReport func(MaskedPII)
+	// Entities sets what the entity handler does with each kind of entity, kinds it does not have are left alone
+	Entities map[EntityKind]EntityMode
+	// EntityTokens overrides DefaultEntityTokens for the kinds it has
+	EntityTokens map[EntityKind]string
+	// EntityReport is called with every entity the entity handler finds, when it is not nil
+	EntityReport func(Entity)
+	// Dictionaries are user-defined replacements, a later dictionary wins over an earlier one
+	Dictionaries []Dictionary
+	// ProtectedTerms are left as they are written in the input, such as promo codes or brand names.
+	// A term matches whole words and is case-sensitive.
+	ProtectedTerms []string
+	// ProtectedPatterns are regular expressions whose matches in the input are left as they are written
+	ProtectedPatterns []*regexp.Regexp
+	// Steps replaces the pipeline built from the flags above when it is not nil
+	Steps []Step
+	// NumberWordDetector, PhoneDetector and PIIDetector find what the word to int, phone and PII masker steps
+	// rewrite. They are implemented by the lfd package, which depends on the normalizer, so seperno.NewNormalize
+	// sets the ones that are nil. A normalizer whose pipeline has one of these steps but not its detector
+	// panics when it is created.
+	NumberWordDetector func(input string) []NumberWord
+	PhoneDetector      func(input string) []PhoneNumber
+	PIIDetector        func(input string) []PII
 }
 
 type Options interface {
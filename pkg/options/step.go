@@ -0,0 +1,26 @@
+package options
+
+// Step is a single stage of the normalization pipeline.
+// Steps run in the order they are given to the normalizer, each one receiving the output of the previous one.
+type Step interface {
+	Name() string
+	Apply(input string) string
+}
+
+type FuncStep struct {
+	name  string
+	apply func(input string) string
+}
+
+func (s FuncStep) Name() string {
+	return s.name
+}
+
+func (s FuncStep) Apply(input string) string {
+	return s.apply(input)
+}
+
+// NewFuncStep creates a user-defined Step from a plain string transformation
+func NewFuncStep(name string, f func(input string) string) *FuncStep {
+	return &FuncStep{name: name, apply: f}
+}